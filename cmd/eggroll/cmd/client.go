@@ -0,0 +1,147 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/gligneul/eggroll/pkg/eggroll"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+	"github.com/spf13/cobra"
+)
+
+var clientArgs struct {
+	dapp       string
+	graphql    string
+	inspect    string
+	rpc        string
+	deployment string
+}
+
+// Add the flags to configure the DApp endpoints to the command.
+// The flags default to the values in the profile.
+func addClientFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&clientArgs.dapp, "dapp", "",
+		"Address of the DApp contract; defaults to the profile or the DApp deployed by sunodo")
+	cmd.PersistentFlags().StringVar(&clientArgs.graphql, "graphql-endpoint", "",
+		"GraphQL endpoint of the rollups node; defaults to the profile")
+	cmd.PersistentFlags().StringVar(&clientArgs.inspect, "inspect-endpoint", "",
+		"Inspect endpoint of the rollups node; defaults to the profile")
+	cmd.PersistentFlags().StringVar(&clientArgs.rpc, "rpc", "",
+		"Ethereum node rpc endpoint; defaults to the profile")
+	cmd.PersistentFlags().StringVar(&clientArgs.deployment, "deployment", "",
+		"Network name or JSON/YAML file with the Cartesi Rollups deployment; "+
+			"defaults to the profile")
+}
+
+// Load the profile with the client flags applied.
+func loadClientProfile() eggroll.Profile {
+	profile := loadProfile()
+	overrideString(&profile.DAppAddress, clientArgs.dapp)
+	overrideString(&profile.GraphqlEndpoint, clientArgs.graphql)
+	overrideString(&profile.InspectEndpoint, clientArgs.inspect)
+	overrideString(&profile.ProviderEndpoint, clientArgs.rpc)
+	overrideString(&profile.Deployment, clientArgs.deployment)
+	return profile
+}
+
+// Set the value if the flag isn't empty.
+func overrideString(value *string, flag string) {
+	if flag != "" {
+		*value = flag
+	}
+}
+
+// Create the client from the profile and the command line flags.
+func loadClient() *eggroll.Client {
+	profile := loadClientProfile()
+	config, err := profile.ClientConfig()
+	cobra.CheckErr(err)
+	client, err := eggroll.NewClient(config)
+	cobra.CheckErr(err)
+	return client
+}
+
+// Create the reader client from the profile and the command line flags.
+// Unlike loadClient, it doesn't connect to the Ethereum node.
+func loadReaderClient() *eggroll.ReaderClient {
+	profile := loadClientProfile()
+	config, err := profile.ClientConfig()
+	cobra.CheckErr(err)
+	return eggroll.NewReaderClient(config)
+}
+
+// Create the client and the signer from the command line flags.
+func loadClientWithSigner(ctx context.Context) (*eggroll.Client, eggeth.Signer) {
+	client := loadClient()
+	return client, loadClientSigner(ctx, client)
+}
+
+// Create the signer from the command line flags for the chain of the client.
+func loadClientSigner(ctx context.Context, client *eggroll.Client) eggeth.Signer {
+	chainId, err := client.Eth.ChainID(ctx)
+	cobra.CheckErr(err)
+	signer, err := eggeth.NewSigner(signerConfigFromArgs(), chainId)
+	cobra.CheckErr(err)
+	return signer
+}
+
+// Decode the payload with the loaded schema into the message kind and args.
+// Return false if there is no schema for the payload.
+func decodePayload(payload []byte) (string, map[string]any, bool) {
+	args := make(map[string]any)
+	kind, err := eggtypes.DecodeIntoMap(args, payload)
+	if err != nil {
+		return "", nil, false
+	}
+	return kind, args, true
+}
+
+// Format the payload decoded with the loaded schema.
+// If there is no schema for the payload, format it as hex.
+func formatPayload(payload []byte) string {
+	kind, args, ok := decodePayload(payload)
+	if !ok {
+		return hexutil.Encode(payload)
+	}
+	jsonArgs, err := json.Marshal(args)
+	if err != nil {
+		return hexutil.Encode(payload)
+	}
+	return fmt.Sprintf("%v %v", kind, string(jsonArgs))
+}
+
+// Print the reports, notices, and vouchers with the given indentation.
+// Log and error reports are printed as log lines.
+func printOutputs(indent string, reports []eggtypes.Report, notices []eggtypes.Notice,
+	vouchers []eggtypes.Voucher) {
+
+	for i, report := range reports {
+		switch value, _ := eggtypes.Decode(report.Payload); value := value.(type) {
+		case eggtypes.Log:
+			fmt.Printf("%vlog: %v\n", indent, value.Message)
+		case eggtypes.Error:
+			fmt.Printf("%verror: %v\n", indent, value.Message)
+		default:
+			fmt.Printf("%vreport %v: %v\n", indent, i, formatPayload(report.Payload))
+		}
+	}
+	for _, notice := range notices {
+		fmt.Printf("%vnotice %v: %v\n", indent, notice.OutputIndex, formatPayload(notice.Payload))
+	}
+	for _, voucher := range vouchers {
+		fmt.Printf("%vvoucher %v: %v %v\n", indent, voucher.OutputIndex, voucher.Destination,
+			formatPayload(voucher.Payload))
+	}
+}
+
+// Print the result of the advance input.
+func printAdvanceResult(result *eggtypes.AdvanceResult) {
+	fmt.Printf("input %v from %v: %v\n", result.Index, result.Sender, result.Status)
+	printOutputs("  ", result.Reports, result.Notices, result.Vouchers)
+}
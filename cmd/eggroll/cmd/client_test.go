@@ -0,0 +1,53 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+)
+
+func TestDecodePayload(t *testing.T) {
+	kind, args, ok := decodePayload(eggtypes.EncodeLog("hello"))
+	if !ok {
+		t.Fatal("failed to decode log")
+	}
+	if kind != "log" {
+		t.Fatalf("wrong kind: %v", kind)
+	}
+	if args["message"] != "hello" {
+		t.Fatalf("wrong args: %v", args)
+	}
+
+	for _, payload := range [][]byte{nil, {0xde, 0xad}, {0xde, 0xad, 0xbe, 0xef, 0x01}} {
+		if _, _, ok := decodePayload(payload); ok {
+			t.Fatalf("decoded payload without schema: %x", payload)
+		}
+	}
+
+	// known id with malformed arguments
+	if _, _, ok := decodePayload(eggtypes.LogID[:]); ok {
+		t.Fatal("decoded malformed log")
+	}
+}
+
+func TestFormatPayload(t *testing.T) {
+	testCases := []struct {
+		payload  []byte
+		expected string
+	}{
+		{eggtypes.EncodeLog("hello"), `log {"message":"hello"}`},
+		{eggtypes.EncodeError("oops"), `error {"message":"oops"}`},
+		{[]byte{}, "0x"},
+		{[]byte{0xde, 0xad, 0xbe, 0xef, 0x01}, "0xdeadbeef01"},
+		{eggtypes.LogID[:], "0x41304fac"},
+	}
+	for _, tc := range testCases {
+		got := formatPayload(tc.payload)
+		if got != tc.expected {
+			t.Errorf("wrong format of %x: got %v; expected %v", tc.payload, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/eggroll/internal/sunodo"
+	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/spf13/cobra"
+)
+
+var dappArgs struct {
+	deployment   string
+	consensus    string
+	owner        string
+	templateHash string
+	salt         string
+}
+
+var dappCmd = &cobra.Command{
+	Use:   "dapp",
+	Short: "Deploy a DApp contract",
+	Long: `
+Deploy a new DApp contract with the CartesiDAppFactory and print its address.
+
+By default, the command reads the template hash from .sunodo/image/hash and uses the
+consensus of the DApp deployed by sunodo, so it should run in the DApp directory.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := contextFromTimeout()
+		defer cancel()
+
+		profile := loadProfile()
+		overrideString(&profile.Deployment, dappArgs.deployment)
+		deployment, err := profile.LoadDeployment()
+		cobra.CheckErr(err)
+
+		var dappAddress common.Address
+		if dappArgs.consensus == "" {
+			var err error
+			dappAddress, err = sunodo.GetDAppAddress()
+			cobra.CheckErr(err)
+		}
+		client, err := eggeth.NewETHClientWithDeployment(deployRPC(), dappAddress, deployment)
+		cobra.CheckErr(err)
+
+		var consensus common.Address
+		if dappArgs.consensus != "" {
+			consensus = parseAddress("consensus", dappArgs.consensus)
+		} else {
+			consensus, err = client.Consensus(ctx)
+			cobra.CheckErr(err)
+		}
+
+		var owner common.Address
+		if dappArgs.owner != "" {
+			owner = parseAddress("owner", dappArgs.owner)
+		}
+
+		var templateHash common.Hash
+		if dappArgs.templateHash != "" {
+			templateHash = parseHash("template hash", dappArgs.templateHash)
+		}
+
+		var salt common.Hash
+		if dappArgs.salt != "" {
+			salt = parseHash("salt", dappArgs.salt)
+		}
+
+		signer := loadSigner(ctx, deployRPC())
+		address, err := client.DeployDApp(ctx, signer, consensus, owner, templateHash, salt, nil)
+		cobra.CheckErr(err)
+		fmt.Println(address)
+	},
+}
+
+// Parse the hex address or exit with an error.
+func parseAddress(name string, value string) common.Address {
+	if !common.IsHexAddress(value) {
+		cobra.CheckErr(fmt.Sprintf("invalid %v address: %v", name, value))
+	}
+	return common.HexToAddress(value)
+}
+
+// Parse the 32-byte hex value or exit with an error.
+func parseHash(name string, value string) common.Hash {
+	bytes := common.FromHex(value)
+	if len(bytes) != common.HashLength {
+		cobra.CheckErr(fmt.Sprintf("invalid %v: %v", name, value))
+	}
+	return common.BytesToHash(bytes)
+}
+
+func init() {
+	deployCmd.AddCommand(dappCmd)
+
+	dappCmd.Flags().StringVar(&dappArgs.deployment, "deployment", "",
+		"Network name or JSON/YAML file with the Cartesi Rollups deployment; "+
+			"defaults to the profile")
+	dappCmd.Flags().StringVar(&dappArgs.consensus, "consensus", "",
+		"Address of the consensus contract; defaults to the consensus of the sunodo DApp")
+	dappCmd.Flags().StringVar(&dappArgs.owner, "owner", "",
+		"Address of the DApp owner; defaults to the signer account")
+	dappCmd.Flags().StringVar(&dappArgs.templateHash, "template-hash", "",
+		"Hash of the Cartesi Machine template; defaults to the content of .sunodo/image/hash")
+	dappCmd.Flags().StringVar(&dappArgs.salt, "salt", "",
+		"Salt used to compute the DApp address; defaults to zero")
+}
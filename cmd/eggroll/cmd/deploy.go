@@ -18,9 +18,18 @@ var deployCmd = &cobra.Command{
 Deploy a contract for testing in a local Ethereum node`,
 }
 
+// Get the rpc endpoint from the flag or the profile.
+func deployRPC() string {
+	if deployArgs.rpc != "" {
+		return deployArgs.rpc
+	}
+	return loadProfile().ProviderEndpoint
+}
+
 func init() {
 	rootCmd.AddCommand(deployCmd)
 
 	deployCmd.PersistentFlags().StringVar(
-		&deployArgs.rpc, "rpc", "http://localhost:8545", "Ethereum node rpc endpoint")
+		&deployArgs.rpc, "rpc", "", "Ethereum node rpc endpoint; defaults to the profile")
+	addSignerFlags(deployCmd)
 }
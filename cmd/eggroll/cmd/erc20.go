@@ -14,11 +14,13 @@ var erc20Cmd = &cobra.Command{
 	Use:   "erc20",
 	Short: "Deploy ERC20 contract",
 	Long: `
-Deploy an ERC20 contract for testing in a local Ethereum node`,
+Deploy an ERC20 contract for testing in a local Ethereum node.
+The signer account receives the initial supply.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx, cancel := contextFromTimeout()
 		defer cancel()
-		address, err := eggeth.DeployTestERC20(ctx, deployArgs.rpc)
+		signer := loadSigner(ctx, deployRPC())
+		address, err := eggeth.DeployTestERC20WithSigner(ctx, deployRPC(), signer)
 		cobra.CheckErr(err)
 		fmt.Println(address)
 	},
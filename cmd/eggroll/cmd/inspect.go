@@ -0,0 +1,51 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+	"github.com/spf13/cobra"
+)
+
+var inspectArgs struct {
+	kind string
+	args string
+}
+
+var inspectCmd = &cobra.Command{
+	Use:     "inspect",
+	Short:   "Send an inspect request to the DApp",
+	Example: `eggroll inspect --kind inspectEcho --args '{"value": "hello"}'`,
+	Long: `Encode the message with the schema and send it to the inspect endpoint of the
+rollups node. Then, print the resulting reports decoded with the schema.`,
+	Run: func(cmd *cobra.Command, _args []string) {
+		schemaLoad()
+
+		args := schemaParseArgs(inspectArgs.args)
+		payload, err := eggtypes.EncodeFromMap(inspectArgs.kind, args)
+		cobra.CheckErr(err)
+
+		ctx, cancel := contextFromTimeout()
+		defer cancel()
+		client := loadReaderClient()
+
+		result, err := client.Inspect(ctx, payload)
+		cobra.CheckErr(err)
+		fmt.Printf("inspect after %v inputs: %v\n", result.ProcessedInputCount, result.Status)
+		printOutputs("  ", result.Reports, nil, nil)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(inspectCmd)
+	addSchemaFlag(inspectCmd)
+	addClientFlags(inspectCmd)
+
+	inspectCmd.Flags().StringVar(&inspectArgs.kind, "kind", "", "Message kind")
+	inspectCmd.MarkFlagRequired("kind")
+
+	inspectCmd.Flags().StringVar(&inspectArgs.args, "args", "{}", "Message args encoded as JSON")
+}
@@ -0,0 +1,153 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/gligneul/eggroll/internal/compiler"
+	"github.com/spf13/cobra"
+)
+
+//go:embed templates
+var newTemplatesFS embed.FS
+
+// Files in the templates directory that are written with another name.
+var newRenamedFiles = map[string]string{
+	"gitignore":    ".gitignore",
+	"dockerignore": ".dockerignore",
+}
+
+// Data used to render the template files.
+type newTemplateData struct {
+	Name   string
+	Module string
+}
+
+var newArgs struct {
+	module   string
+	template string
+}
+
+var newCmd = &cobra.Command{
+	Use:   "new NAME",
+	Short: "Create a new DApp project",
+	Long: `Create a new DApp project in the NAME directory.
+
+The project contains the schema, the contract, the generated bindings, a unit test, and
+the Dockerfile used by sunodo to build the DApp. The available templates are echo,
+wallet, and honeypot.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dir := args[0]
+		data := newTemplateData{
+			Name:   filepath.Base(dir),
+			Module: newArgs.module,
+		}
+		if data.Module == "" {
+			data.Module = data.Name
+		}
+
+		cobra.CheckErr(newProject(dir, newArgs.template, data))
+
+		fmt.Printf("Created %v from the %v template; to get started, run:\n\n",
+			dir, newArgs.template)
+		fmt.Printf("  cd %v\n", dir)
+		fmt.Printf("  go mod tidy\n")
+		fmt.Printf("  go test ./...\n")
+		fmt.Printf("  sunodo build\n")
+	},
+}
+
+// Create the project in the given directory from the template.
+func newProject(dir string, name string, data newTemplateData) error {
+	files, err := newRenderTemplate(name, data)
+	if err != nil {
+		return err
+	}
+
+	binding, err := compiler.YamlSchemaToGoBinding(files["schema.yaml"], "main")
+	if err != nil {
+		return err
+	}
+	files["schema.go"] = binding
+
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%v already exists", dir)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for fileName, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, fileName), content, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Render the common files and the files of the given template.
+// Return a map from the file name to its contents.
+func newRenderTemplate(name string, data newTemplateData) (map[string][]byte, error) {
+	if name == "common" {
+		return nil, fmt.Errorf("template not found: %v", name)
+	}
+	files := make(map[string][]byte)
+	for _, dir := range []string{"common", name} {
+		entries, err := newTemplatesFS.ReadDir(path.Join("templates", dir))
+		if err != nil {
+			return nil, fmt.Errorf("template not found: %v", dir)
+		}
+		for _, entry := range entries {
+			content, err := fs.ReadFile(newTemplatesFS, path.Join("templates", dir, entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			fileName := entry.Name()
+			if strings.HasSuffix(fileName, ".tmpl") {
+				fileName = strings.TrimSuffix(fileName, ".tmpl")
+				content, err = newExecuteTemplate(entry.Name(), content, data)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if renamed, ok := newRenamedFiles[fileName]; ok {
+				fileName = renamed
+			}
+			files[fileName] = content
+		}
+	}
+	return files, nil
+}
+
+// Execute the text template with the given data.
+func newExecuteTemplate(name string, content []byte, data newTemplateData) ([]byte, error) {
+	tmpl, err := template.New(name).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %v: %v", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute %v: %v", name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func init() {
+	rootCmd.AddCommand(newCmd)
+
+	newCmd.Flags().StringVar(
+		&newArgs.module, "module", "", "Go module path of the project (default NAME)")
+
+	newCmd.Flags().StringVar(
+		&newArgs.template, "template", "echo", "Project template: echo, wallet, or honeypot")
+}
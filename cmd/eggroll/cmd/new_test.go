@@ -0,0 +1,69 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewProject(t *testing.T) {
+	for _, name := range []string{"echo", "wallet", "honeypot"} {
+		t.Run(name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "mydapp")
+			data := newTemplateData{Name: "mydapp", Module: "example.com/mydapp"}
+			if err := newProject(dir, name, data); err != nil {
+				t.Fatalf("failed to create project: %v", err)
+			}
+
+			for _, fileName := range []string{
+				"go.mod", "Dockerfile", ".gitignore", ".dockerignore", "eggroll.yaml",
+				"schema.yaml", "schema.go", "contract.go", "contract_test.go",
+			} {
+				if _, err := os.Stat(filepath.Join(dir, fileName)); err != nil {
+					t.Fatalf("missing file: %v", err)
+				}
+			}
+			goMod, _ := os.ReadFile(filepath.Join(dir, "go.mod"))
+			if !strings.Contains(string(goMod), "module example.com/mydapp") {
+				t.Fatalf("wrong go.mod:\n%s", goMod)
+			}
+
+			goFiles, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+			if len(goFiles) != 3 {
+				t.Fatalf("wrong Go files: %v", goFiles)
+			}
+			fset := token.NewFileSet()
+			for _, goFile := range goFiles {
+				file, err := parser.ParseFile(fset, goFile, nil, parser.AllErrors)
+				if err != nil {
+					t.Fatalf("failed to parse generated file: %v", err)
+				}
+				if file.Name.Name != "main" {
+					t.Fatalf("wrong package in %v: %v", goFile, file.Name.Name)
+				}
+			}
+
+			if err := newProject(dir, name, data); err == nil {
+				t.Fatal("expected error when the directory exists")
+			}
+		})
+	}
+}
+
+func TestNewProjectUnknownTemplate(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mydapp")
+	for _, name := range []string{"common", "nope"} {
+		if err := newProject(dir, name, newTemplateData{Name: "mydapp"}); err == nil {
+			t.Fatalf("expected error for template %v", name)
+		}
+	}
+	if _, err := os.Stat(dir); err == nil {
+		t.Fatal("created the directory for an unknown template")
+	}
+}
@@ -8,11 +8,14 @@ import (
 	"os"
 	"time"
 
+	"github.com/gligneul/eggroll/pkg/eggroll"
 	"github.com/spf13/cobra"
 )
 
 var rootArgs struct {
 	timeout int
+	project string
+	profile string
 }
 
 var rootCmd = &cobra.Command{
@@ -30,6 +33,24 @@ func Execute() {
 func init() {
 	rootCmd.PersistentFlags().IntVarP(
 		&rootArgs.timeout, "timeout", "t", 30, "Timeout in secs when executing a command")
+	rootCmd.PersistentFlags().StringVar(
+		&rootArgs.project, "project", eggroll.ProjectFile, "Project configuration file")
+	rootCmd.PersistentFlags().StringVar(
+		&rootArgs.profile, "profile", "",
+		"Profile of the project file (env: EGGROLL_PROFILE); defaults to the project default")
+}
+
+var profile *eggroll.Profile
+
+// Load the profile from the project file.
+// The flags of each command override the profile fields.
+func loadProfile() eggroll.Profile {
+	if profile == nil {
+		p, err := eggroll.LoadProfile(rootArgs.project, rootArgs.profile)
+		cobra.CheckErr(err)
+		profile = &p
+	}
+	return *profile
 }
 
 func contextFromTimeout() (context.Context, context.CancelFunc) {
@@ -5,8 +5,10 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/gligneul/eggroll/internal/compiler"
@@ -25,7 +27,11 @@ var schemaCmd = &cobra.Command{
 
 // Load the input file.
 func schemaLoadInputFile() []byte {
-	inputFile, err := os.Open(schemaArgs.yamlPath)
+	path := schemaArgs.yamlPath
+	if path == "" {
+		path = loadProfile().Schema
+	}
+	inputFile, err := os.Open(path)
 	cobra.CheckErr(err)
 	defer inputFile.Close()
 
@@ -56,9 +62,25 @@ func schemaLoad() string {
 	return string(jsonAbi)
 }
 
+// Parse the message arguments encoded as a JSON object.
+// Numbers are kept as json.Number, so big integers don't lose precision.
+func schemaParseArgs(jsonArgs string) map[string]any {
+	decoder := json.NewDecoder(strings.NewReader(jsonArgs))
+	decoder.UseNumber()
+	args := make(map[string]any)
+	err := decoder.Decode(&args)
+	cobra.CheckErr(err)
+	return args
+}
+
 func init() {
 	rootCmd.AddCommand(schemaCmd)
 
-	schemaCmd.PersistentFlags().StringVar(
-		&schemaArgs.yamlPath, "schema", "schema.yaml", "Yaml file that contains the schema")
+	addSchemaFlag(schemaCmd)
+}
+
+// Add the flag with the path of the schema to the command.
+func addSchemaFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(
+		&schemaArgs.yamlPath, "schema", "", "Yaml file that contains the schema; defaults to the profile")
 }
@@ -4,7 +4,6 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/gligneul/eggroll/pkg/eggtypes"
@@ -25,10 +24,7 @@ var schemaEncodeCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, _args []string) {
 		schemaLoad()
 
-		args := make(map[string]any)
-		err := json.Unmarshal([]byte(schemaEncodeArgs.args), &args)
-		cobra.CheckErr(err)
-
+		args := schemaParseArgs(schemaEncodeArgs.args)
 		payload, err := eggtypes.EncodeFromMap(schemaEncodeArgs.kind, args)
 		cobra.CheckErr(err)
 
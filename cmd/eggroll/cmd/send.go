@@ -0,0 +1,96 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+	"github.com/spf13/cobra"
+)
+
+var sendArgs struct {
+	kind   string
+	args   string
+	ether  string
+	erc20  string
+	amount string
+}
+
+var sendCmd = &cobra.Command{
+	Use:   "send",
+	Short: "Send an advance input to the DApp",
+	Example: `eggroll send --kind withdraw --args '{"value": "100"}'
+eggroll send --kind deposit --ether 1000000000000000000`,
+	Long: `Encode the message with the schema and send it to the DApp through the InputBox.
+
+If --ether or --erc20 is set, the input is sent through the corresponding portal with
+the deposit. Then, the command waits for the DApp to process the input and prints the
+resulting reports, notices, and vouchers decoded with the schema.`,
+	Run: func(cmd *cobra.Command, _args []string) {
+		schemaLoad()
+
+		args := schemaParseArgs(sendArgs.args)
+		payload, err := eggtypes.EncodeFromMap(sendArgs.kind, args)
+		cobra.CheckErr(err)
+
+		ctx, cancel := contextFromTimeout()
+		defer cancel()
+		client, signer := loadClientWithSigner(ctx)
+
+		var inputIndex int
+		switch {
+		case sendArgs.ether != "" && sendArgs.erc20 != "":
+			cobra.CheckErr("--ether and --erc20 are mutually exclusive")
+		case sendArgs.ether != "":
+			value := parseBigInt("ether", sendArgs.ether)
+			inputIndex, err = client.Eth.SendEther(ctx, signer, value, payload, nil)
+		case sendArgs.erc20 != "":
+			token := parseAddress("erc20", sendArgs.erc20)
+			if sendArgs.amount == "" {
+				cobra.CheckErr("missing --amount for the ERC20 deposit")
+			}
+			amount := parseBigInt("amount", sendArgs.amount)
+			inputIndex, err = client.Eth.SendERC20Tokens(ctx, signer, token, amount, payload, nil)
+		default:
+			inputIndex, err = client.Eth.SendInput(ctx, signer, payload, nil)
+		}
+		cobra.CheckErr(err)
+
+		result, err := client.WaitFor(ctx, inputIndex)
+		cobra.CheckErr(err)
+		printAdvanceResult(result)
+	},
+}
+
+// Parse the decimal or hex integer or exit with an error.
+func parseBigInt(name string, value string) *big.Int {
+	n, ok := new(big.Int).SetString(value, 0)
+	if !ok || n.Sign() < 0 {
+		cobra.CheckErr(fmt.Sprintf("invalid %v: %v", name, value))
+	}
+	return n
+}
+
+func init() {
+	rootCmd.AddCommand(sendCmd)
+	addSchemaFlag(sendCmd)
+	addClientFlags(sendCmd)
+	addSignerFlags(sendCmd)
+
+	sendCmd.Flags().StringVar(&sendArgs.kind, "kind", "", "Message kind")
+	sendCmd.MarkFlagRequired("kind")
+
+	sendCmd.Flags().StringVar(&sendArgs.args, "args", "{}", "Message args encoded as JSON")
+
+	sendCmd.Flags().StringVar(&sendArgs.ether, "ether", "",
+		"Amount of Ether in Wei deposited through the Ether portal with the input")
+
+	sendCmd.Flags().StringVar(&sendArgs.erc20, "erc20", "",
+		"Address of the ERC20 token deposited through the ERC20 portal with the input")
+
+	sendCmd.Flags().StringVar(&sendArgs.amount, "amount", "",
+		"Amount of ERC20 tokens deposited with the input")
+}
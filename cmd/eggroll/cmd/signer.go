@@ -0,0 +1,102 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/gligneul/eggroll/pkg/eggroll"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var signerArgs struct {
+	mnemonic           string
+	mnemonicIndex      uint32
+	mnemonicPath       string
+	mnemonicPassphrase string
+	privateKey         string
+	keystorePath       string
+	keystorePassphrase string
+	remoteEndpoint     string
+	remoteAccount      string
+}
+
+// Flags of the mnemonic index, which are checked to know if the index was set.
+var signerIndexFlags []*pflag.Flag
+
+// Add the flags to configure the signer to the command.
+// The flags override the signer of the profile.
+// The secrets may also be set with environment variables.
+func addSignerFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&signerArgs.mnemonic, "mnemonic", "",
+		"Mnemonic used to sign transactions (env: EGGROLL_MNEMONIC); "+
+			"defaults to the Foundry's test mnemonic")
+	cmd.PersistentFlags().Uint32Var(&signerArgs.mnemonicIndex, "mnemonic-index", 0,
+		"Account index of the mnemonic")
+	signerIndexFlags = append(signerIndexFlags, cmd.PersistentFlags().Lookup("mnemonic-index"))
+	cmd.PersistentFlags().StringVar(&signerArgs.mnemonicPath, "mnemonic-path", "",
+		"Derivation path of the mnemonic, without the account index; defaults to "+
+			eggeth.DefaultDerivationPath)
+	cmd.PersistentFlags().StringVar(&signerArgs.mnemonicPassphrase, "mnemonic-passphrase", "",
+		"Passphrase of the mnemonic (env: EGGROLL_MNEMONIC_PASSPHRASE)")
+	cmd.PersistentFlags().StringVar(&signerArgs.privateKey, "private-key", "",
+		"Hex-encoded private key used to sign transactions (env: EGGROLL_PRIVATE_KEY)")
+	cmd.PersistentFlags().StringVar(&signerArgs.keystorePath, "keystore", "",
+		"Path to the JSON keystore file used to sign transactions")
+	cmd.PersistentFlags().StringVar(&signerArgs.keystorePassphrase, "keystore-passphrase", "",
+		"Passphrase of the keystore file (env: EGGROLL_KEYSTORE_PASSPHRASE)")
+	cmd.PersistentFlags().StringVar(&signerArgs.remoteEndpoint, "remote-signer", "",
+		"JSON-RPC endpoint of the remote signer")
+	cmd.PersistentFlags().StringVar(&signerArgs.remoteAccount, "remote-account", "",
+		"Account of the remote signer")
+}
+
+// Create the signer config from the profile and the command line flags.
+func signerConfigFromArgs() eggeth.SignerConfig {
+	signer := loadProfile().Signer
+
+	// A signer set by the flags replaces the signer of the profile.
+	if signerArgs.mnemonic != "" || signerArgs.privateKey != "" ||
+		signerArgs.keystorePath != "" || signerArgs.remoteEndpoint != "" {
+		signer.Mnemonic = eggeth.FoundryMnemonic
+		signer.PrivateKey = ""
+		signer.Keystore = ""
+		signer.RemoteSigner = ""
+	}
+	overrideString(&signer.Mnemonic, signerArgs.mnemonic)
+	overrideString(&signer.MnemonicPath, signerArgs.mnemonicPath)
+	overrideString(&signer.MnemonicPassphrase, signerArgs.mnemonicPassphrase)
+	overrideString(&signer.PrivateKey, signerArgs.privateKey)
+	overrideString(&signer.Keystore, signerArgs.keystorePath)
+	overrideString(&signer.KeystorePassphrase, signerArgs.keystorePassphrase)
+	overrideString(&signer.RemoteSigner, signerArgs.remoteEndpoint)
+	overrideString(&signer.RemoteAccount, signerArgs.remoteAccount)
+	for _, flag := range signerIndexFlags {
+		if flag.Changed {
+			signer.MnemonicIndex = signerArgs.mnemonicIndex
+		}
+	}
+
+	profile := eggroll.Profile{Name: loadProfile().Name, Signer: signer}
+	config, err := profile.SignerConfig()
+	cobra.CheckErr(err)
+	return config
+}
+
+// Load the signer from the command line flags for the chain of the given endpoint.
+func loadSigner(ctx context.Context, endpoint string) eggeth.Signer {
+	client, err := ethclient.DialContext(ctx, endpoint)
+	cobra.CheckErr(err)
+	defer client.Close()
+
+	chainId, err := client.ChainID(ctx)
+	cobra.CheckErr(err)
+
+	signer, err := eggeth.NewSigner(signerConfigFromArgs(), chainId)
+	cobra.CheckErr(err)
+	return signer
+}
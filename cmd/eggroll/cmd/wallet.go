@@ -0,0 +1,35 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+	"github.com/spf13/cobra"
+)
+
+var walletCmd = &cobra.Command{
+	Use:   "wallet",
+	Short: "Commands related to the DApp wallets",
+	Long: `Deposit assets through the portals, withdraw them, check the balances in the DApp
+wallets, and execute the withdrawal vouchers.`,
+}
+
+// Encode the optional message sent with a deposit.
+// Return nil if the kind is empty.
+func walletEncodeMessage(kind string, jsonArgs string) []byte {
+	if kind == "" {
+		return nil
+	}
+	schemaLoad()
+	payload, err := eggtypes.EncodeFromMap(kind, schemaParseArgs(jsonArgs))
+	cobra.CheckErr(err)
+	return payload
+}
+
+func init() {
+	rootCmd.AddCommand(walletCmd)
+	addSchemaFlag(walletCmd)
+	addClientFlags(walletCmd)
+	addSignerFlags(walletCmd)
+}
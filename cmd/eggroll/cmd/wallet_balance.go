@@ -0,0 +1,70 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+	"github.com/spf13/cobra"
+)
+
+var walletBalanceArgs struct {
+	account string
+	token   string
+}
+
+var walletBalanceCmd = &cobra.Command{
+	Use:   "balance",
+	Short: "Get the balance of an account in the DApp wallet",
+	Long: `Get the balance of an account in the DApp wallet with an inspect request.
+
+EggRoll DApps started with RollOpts.WalletInspects answer the eggtypes.EtherBalanceOf and
+eggtypes.ERC20BalanceOf inspects with the balance in the wallet. If --token is set, the command gets the ERC20 balance;
+otherwise, it gets the Ether balance. The account defaults to the signer account.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := contextFromTimeout()
+		defer cancel()
+
+		client := loadClient()
+		var account common.Address
+		if walletBalanceArgs.account != "" {
+			account = parseAddress("account", walletBalanceArgs.account)
+		} else {
+			account = loadClientSigner(ctx, client).Account()
+		}
+
+		if walletBalanceArgs.token == "" {
+			result, err := client.Inspect(ctx, eggtypes.EncodeEtherBalanceOf(account))
+			cobra.CheckErr(err)
+			balance, found := eggtypes.FindReport[eggtypes.EtherBalance](
+				result.Reports, eggtypes.EtherBalanceID)
+			if !found {
+				cobra.CheckErr(fmt.Sprintf("balance not found in inspect result: %v", result.Status))
+			}
+			fmt.Println(balance.Balance)
+		} else {
+			token := parseAddress("token", walletBalanceArgs.token)
+			result, err := client.Inspect(ctx, eggtypes.EncodeERC20BalanceOf(token, account))
+			cobra.CheckErr(err)
+			balance, found := eggtypes.FindReport[eggtypes.ERC20Balance](
+				result.Reports, eggtypes.ERC20BalanceID)
+			if !found {
+				cobra.CheckErr(fmt.Sprintf("balance not found in inspect result: %v", result.Status))
+			}
+			fmt.Println(balance.Balance)
+		}
+	},
+}
+
+func init() {
+	walletCmd.AddCommand(walletBalanceCmd)
+
+	walletBalanceCmd.Flags().StringVar(&walletBalanceArgs.account, "account", "",
+		"Account address; defaults to the signer account")
+
+	walletBalanceCmd.Flags().StringVar(&walletBalanceArgs.token, "token", "",
+		"Address of the ERC20 token; if empty, get the Ether balance")
+}
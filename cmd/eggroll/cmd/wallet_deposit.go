@@ -0,0 +1,87 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var walletDepositArgs struct {
+	kind   string
+	args   string
+	value  string
+	token  string
+	amount string
+}
+
+var walletDepositCmd = &cobra.Command{
+	Use:   "deposit",
+	Short: "Deposit assets to the DApp",
+	Long: `Deposit assets to the DApp through the portals.
+The deposit may have a message encoded with the schema, set with --kind and --args.`,
+}
+
+var walletDepositEtherCmd = &cobra.Command{
+	Use:     "ether",
+	Short:   "Deposit Ether through the Ether portal",
+	Example: `eggroll wallet deposit ether --value 1000000000000000000 --kind deposit`,
+	Run: func(cmd *cobra.Command, args []string) {
+		payload := walletEncodeMessage(walletDepositArgs.kind, walletDepositArgs.args)
+		value := parseBigInt("value", walletDepositArgs.value)
+
+		ctx, cancel := contextFromTimeout()
+		defer cancel()
+		client, signer := loadClientWithSigner(ctx)
+
+		inputIndex, err := client.Eth.SendEther(ctx, signer, value, payload, nil)
+		cobra.CheckErr(err)
+		result, err := client.WaitFor(ctx, inputIndex)
+		cobra.CheckErr(err)
+		printAdvanceResult(result)
+	},
+}
+
+var walletDepositERC20Cmd = &cobra.Command{
+	Use:     "erc20",
+	Short:   "Deposit ERC20 tokens through the ERC20 portal",
+	Example: `eggroll wallet deposit erc20 --token 0x... --amount 100 --kind deposit`,
+	Run: func(cmd *cobra.Command, args []string) {
+		payload := walletEncodeMessage(walletDepositArgs.kind, walletDepositArgs.args)
+		token := parseAddress("token", walletDepositArgs.token)
+		amount := parseBigInt("amount", walletDepositArgs.amount)
+
+		ctx, cancel := contextFromTimeout()
+		defer cancel()
+		client, signer := loadClientWithSigner(ctx)
+
+		inputIndex, err := client.Eth.SendERC20Tokens(ctx, signer, token, amount, payload, nil)
+		cobra.CheckErr(err)
+		result, err := client.WaitFor(ctx, inputIndex)
+		cobra.CheckErr(err)
+		printAdvanceResult(result)
+	},
+}
+
+func init() {
+	walletCmd.AddCommand(walletDepositCmd)
+	walletDepositCmd.AddCommand(walletDepositEtherCmd)
+	walletDepositCmd.AddCommand(walletDepositERC20Cmd)
+
+	walletDepositCmd.PersistentFlags().StringVar(&walletDepositArgs.kind, "kind", "",
+		"Kind of the message sent with the deposit; if empty, send no message")
+	walletDepositCmd.PersistentFlags().StringVar(&walletDepositArgs.args, "args", "{}",
+		"Message args encoded as JSON")
+
+	walletDepositEtherCmd.Flags().StringVar(
+		&walletDepositArgs.value, "value", "", "Amount of Ether in Wei")
+	walletDepositEtherCmd.MarkFlagRequired("value")
+
+	walletDepositERC20Cmd.Flags().StringVar(
+		&walletDepositArgs.token, "token", "", "Address of the ERC20 token")
+	walletDepositERC20Cmd.MarkFlagRequired("token")
+
+	walletDepositERC20Cmd.Flags().StringVar(
+		&walletDepositArgs.amount, "amount", "", "Amount of tokens")
+	walletDepositERC20Cmd.MarkFlagRequired("amount")
+}
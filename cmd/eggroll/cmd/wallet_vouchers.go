@@ -0,0 +1,67 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/gligneul/eggroll/pkg/eggroll"
+	"github.com/spf13/cobra"
+)
+
+var walletVouchersArgs struct {
+	all     bool
+	execute bool
+}
+
+var walletVouchersCmd = &cobra.Command{
+	Use:   "vouchers",
+	Short: "List and execute the pending vouchers",
+	Long: `List the vouchers that weren't executed in the DApp contract yet.
+
+By default, the command only lists the vouchers of the inputs sent by the signer account,
+such as the withdrawals. If --execute is set, the command waits for the proof of each
+pending voucher and executes it; the proof is available after the epoch is finalized.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := contextFromTimeout()
+		defer cancel()
+		client, signer := loadClientWithSigner(ctx)
+
+		opts := eggroll.MakeQueryOpts()
+		if !walletVouchersArgs.all {
+			account := signer.Account()
+			opts.Sender = &account
+		}
+		it := client.Vouchers(ctx, opts)
+		for it.Next() {
+			voucher := it.Value()
+			executed, err := client.VoucherExecuted(ctx, &voucher)
+			cobra.CheckErr(err)
+			if executed {
+				continue
+			}
+			fmt.Printf("voucher %v:%v: %v %v\n", voucher.InputIndex, voucher.OutputIndex,
+				voucher.Destination, formatPayload(voucher.Payload))
+			if !walletVouchersArgs.execute {
+				continue
+			}
+			proven, err := client.WaitForVoucherProof(ctx, voucher.InputIndex, voucher.OutputIndex)
+			cobra.CheckErr(err)
+			err = client.ExecuteVoucher(ctx, signer, proven)
+			cobra.CheckErr(err)
+			fmt.Printf("  executed\n")
+		}
+		cobra.CheckErr(it.Err())
+	},
+}
+
+func init() {
+	walletCmd.AddCommand(walletVouchersCmd)
+
+	walletVouchersCmd.Flags().BoolVar(&walletVouchersArgs.all, "all", false,
+		"If set, list the vouchers of every input instead of only the signer ones")
+
+	walletVouchersCmd.Flags().BoolVar(&walletVouchersArgs.execute, "execute", false,
+		"If set, execute the pending vouchers")
+}
@@ -0,0 +1,46 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var walletWithdrawArgs struct {
+	kind string
+	args string
+}
+
+var walletWithdrawCmd = &cobra.Command{
+	Use:     "withdraw",
+	Short:   "Send a withdraw message to the DApp",
+	Example: `eggroll wallet withdraw --args '{"token": "0x...", "amount": "100"}'`,
+	Long: `Send the withdraw message encoded with the schema and wait for the DApp to process it.
+The resulting vouchers can be executed with 'eggroll wallet vouchers --execute' once the
+epoch of the input is finalized.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		payload := walletEncodeMessage(walletWithdrawArgs.kind, walletWithdrawArgs.args)
+
+		ctx, cancel := contextFromTimeout()
+		defer cancel()
+		client, signer := loadClientWithSigner(ctx)
+
+		inputIndex, err := client.Eth.SendInput(ctx, signer, payload, nil)
+		cobra.CheckErr(err)
+		result, err := client.WaitFor(ctx, inputIndex)
+		cobra.CheckErr(err)
+		printAdvanceResult(result)
+	},
+}
+
+func init() {
+	walletCmd.AddCommand(walletWithdrawCmd)
+
+	walletWithdrawCmd.Flags().StringVar(&walletWithdrawArgs.kind, "kind", "withdraw",
+		"Kind of the withdraw message")
+
+	walletWithdrawCmd.Flags().StringVar(&walletWithdrawArgs.args, "args", "",
+		"Message args encoded as JSON")
+	walletWithdrawCmd.MarkFlagRequired("args")
+}
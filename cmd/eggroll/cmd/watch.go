@@ -0,0 +1,124 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+	"github.com/spf13/cobra"
+)
+
+var watchArgs struct {
+	fromInput int
+	json      bool
+}
+
+// Output of an input in the JSON format.
+// If there is no schema for the payload, the output only has the hex payload.
+type watchOutput struct {
+	Index       int             `json:"index"`
+	Destination *common.Address `json:"destination,omitempty"`
+	Kind        string          `json:"kind,omitempty"`
+	Args        map[string]any  `json:"args,omitempty"`
+	Payload     string          `json:"payload,omitempty"`
+}
+
+// Processed input in the JSON format.
+type watchInput struct {
+	Index    int            `json:"index"`
+	Sender   common.Address `json:"sender"`
+	Status   string         `json:"status"`
+	Reports  []watchOutput  `json:"reports"`
+	Notices  []watchOutput  `json:"notices"`
+	Vouchers []watchOutput  `json:"vouchers"`
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch the outputs of the DApp",
+	Long: `Follow the inputs processed by the DApp and print their outputs.
+
+The reports, notices, and vouchers are decoded with the schema; the payloads with unknown
+IDs are printed as hex. Log and error reports are printed as log lines. The command runs
+until it is interrupted, ignoring the timeout flag.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		schemaLoad()
+		client := loadReaderClient()
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer cancel()
+
+		sub := client.Subscribe(ctx, watchArgs.fromInput)
+		for result := range sub.Results() {
+			if watchArgs.json {
+				watchPrintJSON(result)
+			} else {
+				printAdvanceResult(result)
+			}
+		}
+		if err := sub.Err(); err != nil && err != context.Canceled {
+			cobra.CheckErr(err)
+		}
+	},
+}
+
+// Print the input as a line of JSON.
+func watchPrintJSON(result *eggtypes.AdvanceResult) {
+	input := watchInput{
+		Index:    result.Index,
+		Sender:   result.Sender,
+		Status:   result.Status.String(),
+		Reports:  []watchOutput{},
+		Notices:  []watchOutput{},
+		Vouchers: []watchOutput{},
+	}
+	for i, report := range result.Reports {
+		input.Reports = append(input.Reports, watchDecodeOutput(i, nil, report.Payload))
+	}
+	for _, notice := range result.Notices {
+		input.Notices = append(input.Notices,
+			watchDecodeOutput(notice.OutputIndex, nil, notice.Payload))
+	}
+	for _, voucher := range result.Vouchers {
+		destination := voucher.Destination
+		input.Vouchers = append(input.Vouchers,
+			watchDecodeOutput(voucher.OutputIndex, &destination, voucher.Payload))
+	}
+	line, err := json.Marshal(input)
+	cobra.CheckErr(err)
+	fmt.Println(string(line))
+}
+
+// Decode the output payload with the loaded schema.
+func watchDecodeOutput(index int, destination *common.Address, payload []byte) watchOutput {
+	output := watchOutput{
+		Index:       index,
+		Destination: destination,
+	}
+	if kind, args, ok := decodePayload(payload); ok {
+		output.Kind = kind
+		output.Args = args
+	} else {
+		output.Payload = hexutil.Encode(payload)
+	}
+	return output
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+	addSchemaFlag(watchCmd)
+	addClientFlags(watchCmd)
+
+	watchCmd.Flags().IntVar(&watchArgs.fromInput, "from", 0, "Index of the first input")
+
+	watchCmd.Flags().BoolVar(&watchArgs.json, "json", false,
+		"If set, print each input as a line of JSON")
+}
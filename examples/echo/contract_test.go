@@ -0,0 +1,45 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package main
+
+import (
+	"testing"
+
+	"github.com/gligneul/eggroll/pkg/eggtest"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestContract(t *testing.T) {
+	tester := eggtest.NewTester(Middleware{&Contract{}})
+	defer tester.Close()
+
+	// Test advance
+	sender := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	advanceResult := tester.Advance(sender, EncodeAdvanceEcho("eggroll"))
+	if advanceResult.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", advanceResult.Status)
+	}
+	report, found := eggtypes.FindReport[EchoResponse](advanceResult.Reports, EchoResponseID)
+	if !found {
+		t.Fatalf("echo response not found")
+	}
+	if report.Value != "eggroll" {
+		t.Fatalf("wrong report: %v", report)
+	}
+
+	// Test inspect
+	inspectResult := tester.Inspect(EncodeInspectEcho("rollegg"))
+	if inspectResult.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", inspectResult.Status)
+	}
+	report, found = eggtypes.FindReport[EchoResponse](inspectResult.Reports, EchoResponseID)
+	if !found {
+		t.Fatalf("echo response not found")
+	}
+	if report.Value != "rollegg" {
+		t.Fatalf("wrong report: %v", report)
+	}
+}
@@ -31,7 +31,7 @@ func TestTemplate(t *testing.T) {
 	}
 
 	// Test advance
-	inputIndex, err := client.Eth.SendInput(ctx, signer, EncodeAdvanceEcho("eggroll"))
+	inputIndex, err := client.Eth.SendInput(ctx, signer, EncodeAdvanceEcho("eggroll"), nil)
 	if err != nil {
 		t.Fatalf("failed to send input: %v", err)
 	}
@@ -0,0 +1,60 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package main
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/gligneul/eggroll/pkg/eggtest"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestContract(t *testing.T) {
+	owner := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	tester := eggtest.NewTester(Middleware{&Contract{owner}})
+	defer tester.Close()
+
+	// Send inputs
+	dappAddress := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	tester.RelayDAppAddress(dappAddress)
+	anotherSender := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	result := tester.DepositEther(anotherSender, big.NewInt(100), Deposit{}.Encode())
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	result = tester.Advance(anotherSender, EncodeWithdraw(big.NewInt(50)))
+	if result.Status != eggtypes.CompletionStatusRejected {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	result = tester.Advance(owner, EncodeWithdraw(big.NewInt(50)))
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+
+	// Check returned balance
+	honeypot, found := eggtypes.FindReport[CurrentBalance](result.Reports, CurrentBalanceID)
+	if !found {
+		t.Fatalf("honeypot value not found")
+	}
+	if honeypot.Balance.Cmp(big.NewInt(50)) != 0 {
+		t.Fatal("wrong honeypot balance")
+	}
+
+	// Check voucher
+	if len(result.Vouchers) != 1 {
+		t.Fatal("missing voucher")
+	}
+	voucher := result.Vouchers[0]
+	if voucher.Destination != dappAddress {
+		t.Fatal("wrong voucher destination")
+	}
+	expected := common.Hex2Bytes("522f6815000000000000000000000000f39fd6e51aad88f6f4ce6ab8827279cfffb922660000000000000000000000000000000000000000000000000000000000000032")
+	if !reflect.DeepEqual(voucher.Payload, expected) {
+		t.Fatalf("wrong voucher payload: %v", common.Bytes2Hex(voucher.Payload))
+	}
+}
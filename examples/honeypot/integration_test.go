@@ -35,19 +35,19 @@ func TestHoneypot(t *testing.T) {
 	}
 
 	// Send inputs
-	_, err = client.Eth.SendDAppAddress(ctx, signer)
+	_, err = client.Eth.SendDAppAddress(ctx, signer, nil)
 	if err != nil {
 		t.Fatalf("failed to send dapp address: %v", err)
 	}
 	deposit := Deposit{}
-	_, err = client.Eth.SendEther(ctx, signer, big.NewInt(100), deposit.Encode())
+	_, err = client.Eth.SendEther(ctx, signer, big.NewInt(100), deposit.Encode(), nil)
 	if err != nil {
 		t.Fatalf("failed to send dapp ether: %v", err)
 	}
 	withdraw := Withdraw{
 		Value: big.NewInt(50),
 	}
-	index, err := client.Eth.SendInput(ctx, signer, withdraw.Encode())
+	index, err := client.Eth.SendInput(ctx, signer, withdraw.Encode(), nil)
 	if err != nil {
 		t.Fatalf("failed to send withdraw: %v", err)
 	}
@@ -51,7 +51,7 @@ func sendInputsAndVerifyState(
 	var lastInputIndex int
 	for _, input := range inputs {
 		var err error
-		lastInputIndex, err = client.Eth.SendInput(ctx, signer, input.Encode())
+		lastInputIndex, err = client.Eth.SendInput(ctx, signer, input.Encode(), nil)
 		if err != nil {
 			t.Fatalf("failed to send input: %v", err)
 		}
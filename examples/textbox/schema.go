@@ -187,6 +187,17 @@ func _decode_Clear(values []any) (any, error) {
 	return v, nil
 }
 
+// Copy the value decoded by the ABI, which has anonymous structs, to the Go type.
+func _convert(value any, dst any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	abi.ConvertType(value, dst)
+	return nil
+}
+
 //
 // Init function
 //
@@ -268,6 +279,20 @@ func (m Middleware) Inspect(env eggroll.EnvReader, input []byte) error {
 	return fmt.Errorf("inspect not supported")
 }
 
+// Save the contract state if it implements eggroll.Snapshotter.
+func (m Middleware) Snapshot() {
+	if snapshotter, ok := m.contract.(eggroll.Snapshotter); ok {
+		snapshotter.Snapshot()
+	}
+}
+
+// Restore the contract state if it implements eggroll.Snapshotter.
+func (m Middleware) Restore() {
+	if snapshotter, ok := m.contract.(eggroll.Snapshotter); ok {
+		snapshotter.Restore()
+	}
+}
+
 // Call eggroll.Roll for the contract using the middleware wrapper.
 func Roll(contract iContract) {
 	eggroll.Roll(Middleware{contract})
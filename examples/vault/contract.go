@@ -0,0 +1,48 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package main
+
+//go:generate go run github.com/gligneul/eggroll/cmd/eggroll schema gen
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/gligneul/eggroll/pkg/eggroll"
+	"github.com/gligneul/eggroll/pkg/eggwallets"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Contract struct{}
+
+func (c *Contract) Deposit(env eggroll.Env) error {
+	switch deposit := env.Deposit().(type) {
+	case *eggwallets.ERC20Deposit:
+		env.Log(deposit)
+		balance := env.ERC20BalanceOf(deposit.Token, env.Sender())
+		env.Report(EncodeCurrentBalance(deposit.Token, balance))
+		return nil
+	default:
+		return fmt.Errorf("unsupported deposit: %T", deposit)
+	}
+}
+
+func (c *Contract) Withdraw(env eggroll.Env, token common.Address, amount *big.Int) error {
+	_, err := env.ERC20Withdraw(token, env.Sender(), amount)
+	if err != nil {
+		return err
+	}
+	env.Logf("withdrawn %v\n", amount)
+	balance := env.ERC20BalanceOf(token, env.Sender())
+	env.Report(EncodeCurrentBalance(token, balance))
+	return nil
+}
+
+func main() {
+	// answer the wallet balance inspects used by the eggroll wallet balance command
+	opts := eggroll.RollOpts{WalletInspects: true}
+	log.Fatal(eggroll.RollWithOpts(Middleware{&Contract{}}, opts))
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package main
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/gligneul/eggroll/pkg/eggtest"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestContract(t *testing.T) {
+	tester := eggtest.NewTester(Middleware{&Contract{}})
+	defer tester.Close()
+
+	// Send inputs
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	sender := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	result := tester.DepositERC20(token, sender, big.NewInt(100), Deposit{}.Encode())
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	result = tester.Advance(sender, EncodeWithdraw(token, big.NewInt(150)))
+	if result.Status != eggtypes.CompletionStatusRejected {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	result = tester.Advance(sender, EncodeWithdraw(token, big.NewInt(50)))
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+
+	// Check returned balance
+	balance, found := eggtypes.FindReport[CurrentBalance](result.Reports, CurrentBalanceID)
+	if !found {
+		t.Fatalf("balance not found")
+	}
+	if balance.Token != token || balance.Balance.Cmp(big.NewInt(50)) != 0 {
+		t.Fatalf("wrong balance: %v", balance)
+	}
+
+	// Check voucher
+	if len(result.Vouchers) != 1 {
+		t.Fatal("missing voucher")
+	}
+	voucher := result.Vouchers[0]
+	if voucher.Destination != token {
+		t.Fatal("wrong voucher destination")
+	}
+	expected := common.Hex2Bytes("a9059cbb000000000000000000000000f39fd6e51aad88f6f4ce6ab8827279cfffb922660000000000000000000000000000000000000000000000000000000000000032")
+	if !reflect.DeepEqual(voucher.Payload, expected) {
+		t.Fatalf("wrong voucher payload: %v", common.Bytes2Hex(voucher.Payload))
+	}
+}
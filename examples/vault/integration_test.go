@@ -0,0 +1,98 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package main
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/gligneul/eggroll/pkg/eggroll"
+	"github.com/gligneul/eggroll/pkg/eggtest"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const testTimeout = 300 * time.Second
+
+func TestVault(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	opts := eggtest.LoadIntegrationTesterOpts()
+	opts.DockerContext = "../.."
+	opts.BuildTarget = "vault"
+	opts.EpochDuration = 10 * time.Second
+	tester := eggtest.NewIntegrationTester(ctx, opts, t)
+	defer tester.Close()
+
+	client, signer, err := eggroll.NewDevClient(ctx)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	token, err := eggeth.DeployTestERC20(ctx, client.ProviderEndpoint)
+	if err != nil {
+		t.Fatalf("failed to deploy test erc20: %v", err)
+	}
+
+	// Send inputs
+	_, err = client.Eth.SendERC20Tokens(ctx, signer, token, big.NewInt(100), Deposit{}.Encode(), nil)
+	if err != nil {
+		t.Fatalf("failed to send tokens: %v", err)
+	}
+	index, err := client.Eth.SendInput(ctx, signer, EncodeWithdraw(token, big.NewInt(50)), nil)
+	if err != nil {
+		t.Fatalf("failed to send withdraw: %v", err)
+	}
+
+	// Check returned balance
+	result, err := client.WaitFor(ctx, index)
+	if err != nil {
+		t.Fatalf("failed to wait for result: %v", err)
+	}
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	balance, found := eggtypes.FindReport[CurrentBalance](result.Reports, CurrentBalanceID)
+	if !found {
+		t.Fatalf("balance not found")
+	}
+	if balance.Balance.Cmp(big.NewInt(50)) != 0 {
+		t.Fatalf("wrong balance: %v", balance.Balance)
+	}
+
+	// Check voucher
+	if len(result.Vouchers) != 1 {
+		t.Fatal("missing voucher")
+	}
+	voucher := result.Vouchers[0]
+	if voucher.Destination != token {
+		t.Fatal("wrong voucher destination")
+	}
+	expected := common.Hex2Bytes("a9059cbb000000000000000000000000f39fd6e51aad88f6f4ce6ab8827279cfffb922660000000000000000000000000000000000000000000000000000000000000032")
+	if !reflect.DeepEqual(voucher.Payload, expected) {
+		t.Fatalf("wrong voucher payload: %v", common.Bytes2Hex(voucher.Payload))
+	}
+
+	// Execute voucher
+	provenVoucher, err := client.WaitForVoucherProof(ctx, voucher.InputIndex, voucher.OutputIndex)
+	if err != nil {
+		t.Fatalf("failed to wait for voucher proof: %v", err)
+	}
+	err = client.ExecuteVoucher(ctx, signer, provenVoucher)
+	if err != nil {
+		t.Fatalf("failed to execute voucher: %v", err)
+	}
+	executed, err := client.VoucherExecuted(ctx, provenVoucher)
+	if err != nil {
+		t.Fatalf("failed to check voucher: %v", err)
+	}
+	if !executed {
+		t.Fatal("voucher not executed")
+	}
+}
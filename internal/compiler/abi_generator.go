@@ -88,6 +88,13 @@ func generateAbiArg(name string, type_ any, structs []messageSchema) jsonAbiArg
 			Type:         "bytes",
 			InternalType: "bytes",
 		}
+	case typeFixedBytes:
+		typeName := fmt.Sprintf("bytes%v", type_.Size)
+		return jsonAbiArg{
+			Name:         name,
+			Type:         typeName,
+			InternalType: typeName,
+		}
 	case typeString:
 		return jsonAbiArg{
 			Name:         name,
@@ -96,8 +103,12 @@ func generateAbiArg(name string, type_ any, structs []messageSchema) jsonAbiArg
 		}
 	case typeArray:
 		elemType := generateAbiArg(name, type_.Elem, structs)
-		elemType.Type += "[]"
-		elemType.InternalType += "[]"
+		suffix := "[]"
+		if type_.Length != 0 {
+			suffix = fmt.Sprintf("[%v]", type_.Length)
+		}
+		elemType.Type += suffix
+		elemType.InternalType += suffix
 		return elemType
 	case typeStructRef:
 		struct_ := structs[type_.Index]
@@ -181,6 +181,49 @@ reports:
 	testGenerateAbi(t, input, expected)
 }
 
+func TestGenerateAbiFixedAndNestedArrayTypes(t *testing.T) {
+	input := `
+reports:
+  - name: foo
+    fields:
+      - name: fixed
+        type: uint256[3]
+      - name: nested
+        type: address[][]
+      - name: mixed
+        type: bytes32[2][]
+`
+	expected := `[
+  {
+    "name": "foo",
+    "type": "function",
+    "stateMutability": "nonpayable",
+    "inputs": [
+      {
+        "name": "fixed",
+        "type": "uint256[3]",
+        "internalType": "uint256[3]",
+        "components": null
+      },
+      {
+        "name": "nested",
+        "type": "address[][]",
+        "internalType": "address[][]",
+        "components": null
+      },
+      {
+        "name": "mixed",
+        "type": "bytes32[2][]",
+        "internalType": "bytes32[2][]",
+        "components": null
+      }
+    ],
+    "outputs": null
+  }
+]`
+	testGenerateAbi(t, input, expected)
+}
+
 func TestGenerateAbiStructType(t *testing.T) {
 	input := `
 structs:
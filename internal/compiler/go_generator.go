@@ -21,18 +21,20 @@ type tmplData struct {
 }
 
 type tmplMessageSchema struct {
-	Kind   string
-	Doc    string
-	GoName string
-	ID     string
-	Fields []tmplFieldSchema
+	Kind    string
+	Doc     string
+	GoName  string
+	ID      string
+	Fields  []tmplFieldSchema
+	Asserts bool
 }
 
 type tmplFieldSchema struct {
-	Kind   string
-	Doc    string
-	GoName string
-	Type   string
+	Kind    string
+	Doc     string
+	GoName  string
+	Type    string
+	Convert bool
 }
 
 // Generate the EggRoll Go binding for the ast.
@@ -91,7 +93,9 @@ func generateTmplMessage(message messageSchema, structs []messageSchema) tmplMes
 		tmplField.Doc = generateDoc(field.Doc)
 		tmplField.GoName = captalize(field.Name)
 		tmplField.Type = generateGoType(field.type_, structs)
+		tmplField.Convert = hasStruct(field.type_)
 		tmplMessage.Fields = append(tmplMessage.Fields, tmplField)
+		tmplMessage.Asserts = tmplMessage.Asserts || !tmplField.Convert
 	}
 	return tmplMessage
 }
@@ -115,10 +119,16 @@ func generateGoType(type_ any, structs []messageSchema) string {
 		return "common.Address"
 	case typeBytes:
 		return "[]byte"
+	case typeFixedBytes:
+		return fmt.Sprintf("[%v]byte", type_.Size)
 	case typeString:
 		return "string"
 	case typeArray:
-		return "[]" + generateGoType(type_.Elem, structs)
+		prefix := "[]"
+		if type_.Length != 0 {
+			prefix = fmt.Sprintf("[%v]", type_.Length)
+		}
+		return prefix + generateGoType(type_.Elem, structs)
 	case typeStructRef:
 		struct_ := structs[type_.Index]
 		return captalize(struct_.Name)
@@ -128,6 +138,19 @@ func generateGoType(type_ any, structs []messageSchema) string {
 	}
 }
 
+// Check whether the type has a struct.
+// The ABI decodes structs as anonymous Go structs, so these types can't be type-asserted.
+func hasStruct(type_ any) bool {
+	switch type_ := type_.(type) {
+	case typeArray:
+		return hasStruct(type_.Elem)
+	case typeStructRef:
+		return true
+	default:
+		return false
+	}
+}
+
 // Prefix each line of the doc string with //
 func generateDoc(doc string) string {
 	if doc == "" {
@@ -241,20 +264,37 @@ var _abi abi.ABI
 		if len(values) != {{len $schema.Fields}} {
 			return nil, fmt.Errorf("wrong number of values")
 		}
-		{{- if $schema.Fields}}
+		{{- if $schema.Asserts}}
 			var ok bool
 		{{- end}}
 		var v {{$schema.GoName}}
 		{{- range $i, $field := .Fields}}
-			v.{{$field.GoName}}, ok = values[{{$i}}].({{$field.Type}})
-			if !ok {
-				return nil, fmt.Errorf("failed to decode {{$schema.Kind}}.{{$field.Kind}}")
-			}
+			{{- if $field.Convert}}
+				if err := _convert(values[{{$i}}], &v.{{$field.GoName}}); err != nil {
+					return nil, fmt.Errorf("failed to decode {{$schema.Kind}}.{{$field.Kind}}: %v", err)
+				}
+			{{- else}}
+				v.{{$field.GoName}}, ok = values[{{$i}}].({{$field.Type}})
+				if !ok {
+					return nil, fmt.Errorf("failed to decode {{$schema.Kind}}.{{$field.Kind}}")
+				}
+			{{- end}}
 		{{- end}}
 		return v, nil
 	}
 {{end}}
 
+// Copy the value decoded by the ABI, which has anonymous structs, to the Go type.
+func _convert(value any, dst any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	abi.ConvertType(value, dst)
+	return nil
+}
+
 //
 // Init function
 //
@@ -360,6 +400,20 @@ func (m Middleware) Inspect(env eggroll.EnvReader, input []byte) error {
 	{{- end}}
 }
 
+// Save the contract state if it implements eggroll.Snapshotter.
+func (m Middleware) Snapshot() {
+	if snapshotter, ok := m.contract.(eggroll.Snapshotter); ok {
+		snapshotter.Snapshot()
+	}
+}
+
+// Restore the contract state if it implements eggroll.Snapshotter.
+func (m Middleware) Restore() {
+	if snapshotter, ok := m.contract.(eggroll.Snapshotter); ok {
+		snapshotter.Restore()
+	}
+}
+
 // Call eggroll.Roll for the contract using the middleware wrapper.
 func Roll(contract iContract) {
 	eggroll.Roll(Middleware{contract})
@@ -6,6 +6,7 @@ package compiler
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -88,19 +89,46 @@ func checkName(name string) error {
 	return nil
 }
 
-func tokenizeType(rawType string) (name string, isArray bool, err error) {
+// Split the type into the name and the array suffixes.
+// Each suffix has the array length, or zero for dynamic arrays, in the declaration order;
+// so, for bytes32[2][], the result is bytes32 and [2, 0].
+func tokenizeType(rawType string) (name string, arrays []int, err error) {
 	openBracketIndex := strings.IndexRune(rawType, '[')
 	if openBracketIndex != -1 {
-		isArray = true
-		if len(rawType) != (openBracketIndex+2) || rawType[openBracketIndex+1] != ']' {
-			return "", false, fmt.Errorf("invalid array; only [] is supported")
-		}
 		name = rawType[:openBracketIndex]
+		arrays, err = tokenizeArrays(rawType[openBracketIndex:])
+		if err != nil {
+			return "", nil, err
+		}
 	} else {
 		name = rawType
 	}
 	if err := checkName(name); err != nil {
-		return "", false, err
+		return "", nil, err
+	}
+	return name, arrays, nil
+}
+
+var arrayPattern = regexp.MustCompile(`^\[([0-9]*)\]`)
+
+// Parse the array suffixes, such as [][3].
+func tokenizeArrays(suffix string) ([]int, error) {
+	var arrays []int
+	for suffix != "" {
+		match := arrayPattern.FindStringSubmatch(suffix)
+		if match == nil {
+			return nil, fmt.Errorf("invalid array %q", suffix)
+		}
+		length := 0
+		if match[1] != "" {
+			var err error
+			length, err = strconv.Atoi(match[1])
+			if err != nil || length == 0 {
+				return nil, fmt.Errorf("invalid array length %q", match[1])
+			}
+		}
+		arrays = append(arrays, length)
+		suffix = suffix[len(match[0]):]
 	}
-	return name, isArray, nil
+	return arrays, nil
 }
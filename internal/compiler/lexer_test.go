@@ -3,7 +3,10 @@
 
 package compiler
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestCheckIdentifier(t *testing.T) {
 	testCases := []struct {
@@ -43,49 +46,58 @@ func TestTokenizeType(t *testing.T) {
 	testCases := []struct {
 		rawType string
 		id      string
-		isArray bool
+		arrays  []int
 		isErr   bool
 	}{
 		// valid ids
-		{"f", "f", false, false},
-		{"foo", "foo", false, false},
-		{"fooBar", "fooBar", false, false},
-		{"F", "F", false, false},
-		{"Foo", "Foo", false, false},
-		{"FB", "FB", false, false},
-		{"FooBar", "FooBar", false, false},
-		{"FooBar123", "FooBar123", false, false},
+		{"f", "f", nil, false},
+		{"foo", "foo", nil, false},
+		{"fooBar", "fooBar", nil, false},
+		{"F", "F", nil, false},
+		{"Foo", "Foo", nil, false},
+		{"FB", "FB", nil, false},
+		{"FooBar", "FooBar", nil, false},
+		{"FooBar123", "FooBar123", nil, false},
 
 		// valid arrays
-		{"f[]", "f", true, false},
-		{"fooBar[]", "fooBar", true, false},
-		{"foo123[]", "foo123", true, false},
+		{"f[]", "f", []int{0}, false},
+		{"fooBar[]", "fooBar", []int{0}, false},
+		{"foo123[]", "foo123", []int{0}, false},
+		{"foo[3]", "foo", []int{3}, false},
+		{"foo[][]", "foo", []int{0, 0}, false},
+		{"foo[2][]", "foo", []int{2, 0}, false},
+		{"foo[][10][1]", "foo", []int{0, 10, 1}, false},
 
 		// error cases
-		{"[]", "", false, true},
-		{"foo[", "", false, true},
-		{"fo[o]", "", false, true},
-		{"foo]", "", false, true},
-		{"foo_bar", "", false, true},
-		{"_", "", false, true},
-		{"_foo", "", false, true},
-		{"1foo", "", false, true},
+		{"[]", "", nil, true},
+		{"foo[", "", nil, true},
+		{"fo[o]", "", nil, true},
+		{"foo]", "", nil, true},
+		{"foo[]]", "", nil, true},
+		{"foo[][", "", nil, true},
+		{"foo[0]", "", nil, true},
+		{"foo[-1]", "", nil, true},
+		{"foo[1]bar", "", nil, true},
+		{"foo_bar", "", nil, true},
+		{"_", "", nil, true},
+		{"_foo", "", nil, true},
+		{"1foo", "", nil, true},
 	}
 	for _, testCase := range testCases {
-		id, isArray, err := tokenizeType(testCase.rawType)
+		id, arrays, err := tokenizeType(testCase.rawType)
 		if !testCase.isErr {
 			if err != nil {
 				t.Fatalf("unexpected err for %q: %v", testCase.rawType, err)
 			}
-			if id != testCase.id || isArray != testCase.isArray {
+			if id != testCase.id || !reflect.DeepEqual(arrays, testCase.arrays) {
 				t.Fatalf("expected %q, %v for %q; got %q, %v",
-					testCase.id, testCase.isArray, testCase.rawType, id, isArray)
+					testCase.id, testCase.arrays, testCase.rawType, id, arrays)
 			}
 		}
 		if testCase.isErr {
 			t.Log(err)
 			if err == nil {
-				t.Fatalf("expected err for %v", testCase.id)
+				t.Fatalf("expected err for %v", testCase.rawType)
 			}
 		}
 	}
@@ -52,7 +52,7 @@ func parseMessages(messages []messageSchema) error {
 }
 
 func parseType(rawType string) (any, error) {
-	typeName, isArray, err := tokenizeType(rawType)
+	typeName, arrays, err := tokenizeType(rawType)
 	if err != nil {
 		return nil, err
 	}
@@ -63,8 +63,8 @@ func parseType(rawType string) (any, error) {
 			Name: typeName,
 		}
 	}
-	if isArray {
-		type_ = typeArray{Elem: type_}
+	for _, length := range arrays {
+		type_ = typeArray{Elem: type_, Length: length}
 	}
 	return type_, nil
 }
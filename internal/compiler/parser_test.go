@@ -101,8 +101,14 @@ reports:
         type: string
       - name: bytes
         type: bytes
+      - name: bytes32
+        type: bytes32
       - name: array
         type: bool[]
+      - name: fixedArray
+        type: uint256[3]
+      - name: nestedArray
+        type: bytes32[2][]
       - name: structRef
         type: bar
 `))
@@ -121,7 +127,15 @@ reports:
 					{Name: "address", Type: "address", type_: typeAddress{}},
 					{Name: "string", Type: "string", type_: typeString{}},
 					{Name: "bytes", Type: "bytes", type_: typeBytes{}},
+					{Name: "bytes32", Type: "bytes32", type_: typeFixedBytes{32}},
 					{Name: "array", Type: "bool[]", type_: typeArray{Elem: typeBool{}}},
+					{Name: "fixedArray", Type: "uint256[3]", type_: typeArray{
+						Elem:   typeInt{false, 256},
+						Length: 3,
+					}},
+					{Name: "nestedArray", Type: "bytes32[2][]", type_: typeArray{
+						Elem: typeArray{Elem: typeFixedBytes{32}, Length: 2},
+					}},
 					{Name: "structRef", Type: "bar", type_: typeStructRef{Name: "bar"}},
 				},
 			},
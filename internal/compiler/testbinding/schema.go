@@ -139,6 +139,12 @@ const _JSON_ABI = `[
         "type": "bytes",
         "internalType": "bytes",
         "components": null
+      },
+      {
+        "name": "bytes32",
+        "type": "bytes32",
+        "internalType": "bytes32",
+        "components": null
       }
     ],
     "outputs": null
@@ -192,6 +198,52 @@ const _JSON_ABI = `[
     ],
     "outputs": null
   },
+  {
+    "name": "fixedArrayAdvance",
+    "type": "function",
+    "stateMutability": "nonpayable",
+    "inputs": [
+      {
+        "name": "fixed",
+        "type": "uint256[3]",
+        "internalType": "uint256[3]",
+        "components": null
+      },
+      {
+        "name": "nested",
+        "type": "address[][]",
+        "internalType": "address[][]",
+        "components": null
+      },
+      {
+        "name": "mixed",
+        "type": "bytes32[2][]",
+        "internalType": "bytes32[2][]",
+        "components": null
+      },
+      {
+        "name": "structs",
+        "type": "tuple[2][]",
+        "internalType": "struct nestedStruct[2][]",
+        "components": [
+          {
+            "name": "value",
+            "type": "tuple",
+            "internalType": "struct simpleStruct",
+            "components": [
+              {
+                "name": "value",
+                "type": "int64",
+                "internalType": "int64",
+                "components": null
+              }
+            ]
+          }
+        ]
+      }
+    ],
+    "outputs": null
+  },
   {
     "name": "inspectMessage",
     "type": "function",
@@ -253,6 +305,7 @@ type BasicTypesAdvance struct {
 	Address common.Address
 	String  string
 	Bytes   []byte
+	Bytes32 [32]byte
 }
 
 // Advance with struct value
@@ -265,6 +318,14 @@ type ArrayAdvance struct {
 	Value []SimpleStruct
 }
 
+// Advance with fixed-size and nested arrays
+type FixedArrayAdvance struct {
+	Fixed   [3]*big.Int
+	Nested  [][]common.Address
+	Mixed   [][2][32]byte
+	Structs [][2]NestedStruct
+}
+
 // Empty inspect message
 type InspectMessage struct {
 }
@@ -294,6 +355,9 @@ var StructAdvanceID eggtypes.ID
 // 4-byte function selector of ArrayAdvance
 var ArrayAdvanceID eggtypes.ID
 
+// 4-byte function selector of fixedArrayAdvance
+var FixedArrayAdvanceID eggtypes.ID
+
 // 4-byte function selector of inspectMessage
 var InspectMessageID eggtypes.ID
 
@@ -389,8 +453,9 @@ func EncodeBasicTypesAdvance(
 	Address common.Address,
 	String string,
 	Bytes []byte,
+	Bytes32 [32]byte,
 ) []byte {
-	values := make([]any, 10)
+	values := make([]any, 11)
 	values[0] = Bool
 	values[1] = Int
 	values[2] = Int8
@@ -401,6 +466,7 @@ func EncodeBasicTypesAdvance(
 	values[7] = Address
 	values[8] = String
 	values[9] = Bytes
+	values[10] = Bytes32
 	data, err := _abi.Methods["basicTypesAdvance"].Inputs.PackValues(values)
 	if err != nil {
 		panic(fmt.Sprintf("failed to encode basicTypesAdvance: %v", err))
@@ -421,6 +487,7 @@ func (v BasicTypesAdvance) Encode() []byte {
 		v.Address,
 		v.String,
 		v.Bytes,
+		v.Bytes32,
 	)
 }
 
@@ -464,6 +531,35 @@ func (v ArrayAdvance) Encode() []byte {
 	)
 }
 
+// Encode fixedArrayAdvance into binary data.
+func EncodeFixedArrayAdvance(
+	Fixed [3]*big.Int,
+	Nested [][]common.Address,
+	Mixed [][2][32]byte,
+	Structs [][2]NestedStruct,
+) []byte {
+	values := make([]any, 4)
+	values[0] = Fixed
+	values[1] = Nested
+	values[2] = Mixed
+	values[3] = Structs
+	data, err := _abi.Methods["fixedArrayAdvance"].Inputs.PackValues(values)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode fixedArrayAdvance: %v", err))
+	}
+	return append(FixedArrayAdvanceID[:], data...)
+}
+
+// Encode fixedArrayAdvance into binary data.
+func (v FixedArrayAdvance) Encode() []byte {
+	return EncodeFixedArrayAdvance(
+		v.Fixed,
+		v.Nested,
+		v.Mixed,
+		v.Structs,
+	)
+}
+
 // Encode inspectMessage into binary data.
 func EncodeInspectMessage() []byte {
 	values := make([]any, 0)
@@ -534,7 +630,7 @@ func _decode_MultiFieldAdvance(values []any) (any, error) {
 }
 
 func _decode_BasicTypesAdvance(values []any) (any, error) {
-	if len(values) != 10 {
+	if len(values) != 11 {
 		return nil, fmt.Errorf("wrong number of values")
 	}
 	var ok bool
@@ -579,6 +675,10 @@ func _decode_BasicTypesAdvance(values []any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("failed to decode basicTypesAdvance.bytes")
 	}
+	v.Bytes32, ok = values[10].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode basicTypesAdvance.bytes32")
+	}
 	return v, nil
 }
 
@@ -586,11 +686,9 @@ func _decode_StructAdvance(values []any) (any, error) {
 	if len(values) != 1 {
 		return nil, fmt.Errorf("wrong number of values")
 	}
-	var ok bool
 	var v StructAdvance
-	v.Value, ok = values[0].(NestedStruct)
-	if !ok {
-		return nil, fmt.Errorf("failed to decode structAdvance.value")
+	if err := _convert(values[0], &v.Value); err != nil {
+		return nil, fmt.Errorf("failed to decode structAdvance.value: %v", err)
 	}
 	return v, nil
 }
@@ -599,11 +697,33 @@ func _decode_ArrayAdvance(values []any) (any, error) {
 	if len(values) != 1 {
 		return nil, fmt.Errorf("wrong number of values")
 	}
-	var ok bool
 	var v ArrayAdvance
-	v.Value, ok = values[0].([]SimpleStruct)
+	if err := _convert(values[0], &v.Value); err != nil {
+		return nil, fmt.Errorf("failed to decode ArrayAdvance.value: %v", err)
+	}
+	return v, nil
+}
+
+func _decode_FixedArrayAdvance(values []any) (any, error) {
+	if len(values) != 4 {
+		return nil, fmt.Errorf("wrong number of values")
+	}
+	var ok bool
+	var v FixedArrayAdvance
+	v.Fixed, ok = values[0].([3]*big.Int)
 	if !ok {
-		return nil, fmt.Errorf("failed to decode ArrayAdvance.value")
+		return nil, fmt.Errorf("failed to decode fixedArrayAdvance.fixed")
+	}
+	v.Nested, ok = values[1].([][]common.Address)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode fixedArrayAdvance.nested")
+	}
+	v.Mixed, ok = values[2].([][2][32]byte)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode fixedArrayAdvance.mixed")
+	}
+	if err := _convert(values[3], &v.Structs); err != nil {
+		return nil, fmt.Errorf("failed to decode fixedArrayAdvance.structs: %v", err)
 	}
 	return v, nil
 }
@@ -616,6 +736,17 @@ func _decode_InspectMessage(values []any) (any, error) {
 	return v, nil
 }
 
+// Copy the value decoded by the ABI, which has anonymous structs, to the Go type.
+func _convert(value any, dst any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	abi.ConvertType(value, dst)
+	return nil
+}
+
 //
 // Init function
 //
@@ -676,6 +807,13 @@ func init() {
 		Arguments: _abi.Methods["ArrayAdvance"].Inputs,
 		Decoder:   _decode_ArrayAdvance,
 	})
+	FixedArrayAdvanceID = eggtypes.ID(_abi.Methods["fixedArrayAdvance"].ID)
+	eggtypes.MustAddSchema(eggtypes.MessageSchema{
+		ID:        FixedArrayAdvanceID,
+		Kind:      "fixedArrayAdvance",
+		Arguments: _abi.Methods["fixedArrayAdvance"].Inputs,
+		Decoder:   _decode_FixedArrayAdvance,
+	})
 	InspectMessageID = eggtypes.ID(_abi.Methods["inspectMessage"].ID)
 	eggtypes.MustAddSchema(eggtypes.MessageSchema{
 		ID:        InspectMessageID,
@@ -725,6 +863,7 @@ type iContract interface {
 		common.Address,
 		string,
 		[]byte,
+		[32]byte,
 	) error
 
 	// Advance with struct value
@@ -739,6 +878,15 @@ type iContract interface {
 		[]SimpleStruct,
 	) error
 
+	// Advance with fixed-size and nested arrays
+	FixedArrayAdvance(
+		eggroll.Env,
+		[3]*big.Int,
+		[][]common.Address,
+		[][2][32]byte,
+		[][2]NestedStruct,
+	) error
+
 	// Empty inspect message
 	InspectMessage(
 		eggroll.EnvReader,
@@ -786,6 +934,7 @@ func (m Middleware) Advance(env eggroll.Env, input []byte) error {
 			input.Address,
 			input.String,
 			input.Bytes,
+			input.Bytes32,
 		)
 	case StructAdvance:
 		return m.contract.StructAdvance(
@@ -797,6 +946,14 @@ func (m Middleware) Advance(env eggroll.Env, input []byte) error {
 			env,
 			input.Value,
 		)
+	case FixedArrayAdvance:
+		return m.contract.FixedArrayAdvance(
+			env,
+			input.Fixed,
+			input.Nested,
+			input.Mixed,
+			input.Structs,
+		)
 	default:
 		return fmt.Errorf("unknown input: %T", input)
 	}
@@ -817,6 +974,20 @@ func (m Middleware) Inspect(env eggroll.EnvReader, input []byte) error {
 	}
 }
 
+// Save the contract state if it implements eggroll.Snapshotter.
+func (m Middleware) Snapshot() {
+	if snapshotter, ok := m.contract.(eggroll.Snapshotter); ok {
+		snapshotter.Snapshot()
+	}
+}
+
+// Restore the contract state if it implements eggroll.Snapshotter.
+func (m Middleware) Restore() {
+	if snapshotter, ok := m.contract.(eggroll.Snapshotter); ok {
+		snapshotter.Restore()
+	}
+}
+
 func Roll(contract iContract) {
 	eggroll.Roll(Middleware{contract})
 }
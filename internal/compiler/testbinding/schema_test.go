@@ -0,0 +1,68 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package testbinding
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+)
+
+type encoder interface {
+	Encode() []byte
+}
+
+func TestEncodeDecode(t *testing.T) {
+	testCases := []encoder{
+		EmptyAdvance{},
+		MultiFieldAdvance{
+			IntValue:    -1,
+			BoolValue:   true,
+			StringValue: "hello",
+		},
+		BasicTypesAdvance{
+			Bool:    true,
+			Int:     big.NewInt(-10),
+			Int8:    -8,
+			Int256:  big.NewInt(256),
+			Uint:    big.NewInt(10),
+			Uint8:   8,
+			Uint256: big.NewInt(1000),
+			Address: common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa"),
+			String:  "hello",
+			Bytes:   []byte{1, 2, 3},
+			Bytes32: [32]byte{1, 2, 3},
+		},
+		StructAdvance{
+			Value: NestedStruct{Value: SimpleStruct{Value: 42}},
+		},
+		ArrayAdvance{
+			Value: []SimpleStruct{{Value: 1}, {Value: 2}},
+		},
+		FixedArrayAdvance{
+			Fixed: [3]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)},
+			Nested: [][]common.Address{
+				{common.HexToAddress("0x01"), common.HexToAddress("0x02")},
+				{},
+				{common.HexToAddress("0x03")},
+			},
+			Mixed: [][2][32]byte{{{1}, {2}}, {{3}, {4}}},
+			Structs: [][2]NestedStruct{
+				{{Value: SimpleStruct{Value: 1}}, {Value: SimpleStruct{Value: 2}}},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		value, err := eggtypes.Decode(testCase.Encode())
+		if err != nil {
+			t.Fatalf("failed to decode %T: %v", testCase, err)
+		}
+		if !reflect.DeepEqual(value, testCase) {
+			t.Fatalf("wrong value; expected %#v; got %#v", testCase, value)
+		}
+	}
+}
@@ -19,6 +19,9 @@ func init() {
 		basicTypes[fmt.Sprintf("int%v", i)] = typeInt{true, i}
 		basicTypes[fmt.Sprintf("uint%v", i)] = typeInt{false, i}
 	}
+	for i := 1; i <= 32; i++ {
+		basicTypes[fmt.Sprintf("bytes%v", i)] = typeFixedBytes{i}
+	}
 }
 
 type typeBool struct{}
@@ -32,10 +35,16 @@ type typeAddress struct{}
 
 type typeBytes struct{}
 
+type typeFixedBytes struct {
+	Size int
+}
+
 type typeString struct{}
 
+// Array of elements; if the length is zero, the array is dynamic.
 type typeArray struct {
-	Elem any
+	Elem   any
+	Length int
 }
 
 type typeStructRef struct {
@@ -4,6 +4,7 @@ package reader
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/Khan/genqlient/graphql"
 )
@@ -21,6 +22,20 @@ const (
 	CompletionStatusPayloadLengthLimitExceeded CompletionStatus = "PAYLOAD_LENGTH_LIMIT_EXCEEDED"
 )
 
+// Filter object to restrict results depending on input properties
+type InputFilter struct {
+	// Filter only inputs with index lower than a given value
+	IndexLowerThan *int `json:"indexLowerThan,omitempty"`
+	// Filter only inputs with index greater than a given value
+	IndexGreaterThan *int `json:"indexGreaterThan,omitempty"`
+}
+
+// GetIndexLowerThan returns InputFilter.IndexLowerThan, and is useful for accessing the field via an interface.
+func (v *InputFilter) GetIndexLowerThan() *int { return v.IndexLowerThan }
+
+// GetIndexGreaterThan returns InputFilter.IndexGreaterThan, and is useful for accessing the field via an interface.
+func (v *InputFilter) GetIndexGreaterThan() *int { return v.IndexGreaterThan }
+
 // __getInputInput is used internally by genqlient
 type __getInputInput struct {
 	InputIndex int `json:"inputIndex"`
@@ -29,269 +44,3038 @@ type __getInputInput struct {
 // GetInputIndex returns __getInputInput.InputIndex, and is useful for accessing the field via an interface.
 func (v *__getInputInput) GetInputIndex() int { return v.InputIndex }
 
+// __getInputNoticesInput is used internally by genqlient
+type __getInputNoticesInput struct {
+	InputIndex int     `json:"inputIndex"`
+	First      int     `json:"first"`
+	After      *string `json:"after"`
+}
+
+// GetInputIndex returns __getInputNoticesInput.InputIndex, and is useful for accessing the field via an interface.
+func (v *__getInputNoticesInput) GetInputIndex() int { return v.InputIndex }
+
+// GetFirst returns __getInputNoticesInput.First, and is useful for accessing the field via an interface.
+func (v *__getInputNoticesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __getInputNoticesInput.After, and is useful for accessing the field via an interface.
+func (v *__getInputNoticesInput) GetAfter() *string { return v.After }
+
+// __getInputReportsInput is used internally by genqlient
+type __getInputReportsInput struct {
+	InputIndex int     `json:"inputIndex"`
+	First      int     `json:"first"`
+	After      *string `json:"after"`
+}
+
+// GetInputIndex returns __getInputReportsInput.InputIndex, and is useful for accessing the field via an interface.
+func (v *__getInputReportsInput) GetInputIndex() int { return v.InputIndex }
+
+// GetFirst returns __getInputReportsInput.First, and is useful for accessing the field via an interface.
+func (v *__getInputReportsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __getInputReportsInput.After, and is useful for accessing the field via an interface.
+func (v *__getInputReportsInput) GetAfter() *string { return v.After }
+
+// __getInputVouchersInput is used internally by genqlient
+type __getInputVouchersInput struct {
+	InputIndex int     `json:"inputIndex"`
+	First      int     `json:"first"`
+	After      *string `json:"after"`
+}
+
+// GetInputIndex returns __getInputVouchersInput.InputIndex, and is useful for accessing the field via an interface.
+func (v *__getInputVouchersInput) GetInputIndex() int { return v.InputIndex }
+
+// GetFirst returns __getInputVouchersInput.First, and is useful for accessing the field via an interface.
+func (v *__getInputVouchersInput) GetFirst() int { return v.First }
+
+// GetAfter returns __getInputVouchersInput.After, and is useful for accessing the field via an interface.
+func (v *__getInputVouchersInput) GetAfter() *string { return v.After }
+
+// __getInputsInput is used internally by genqlient
+type __getInputsInput struct {
+	First int         `json:"first"`
+	After *string     `json:"after"`
+	Where InputFilter `json:"where"`
+}
+
+// GetFirst returns __getInputsInput.First, and is useful for accessing the field via an interface.
+func (v *__getInputsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __getInputsInput.After, and is useful for accessing the field via an interface.
+func (v *__getInputsInput) GetAfter() *string { return v.After }
+
+// GetWhere returns __getInputsInput.Where, and is useful for accessing the field via an interface.
+func (v *__getInputsInput) GetWhere() InputFilter { return v.Where }
+
+// __getNoticeInput is used internally by genqlient
+type __getNoticeInput struct {
+	NoticeIndex int `json:"noticeIndex"`
+	InputIndex  int `json:"inputIndex"`
+}
+
+// GetNoticeIndex returns __getNoticeInput.NoticeIndex, and is useful for accessing the field via an interface.
+func (v *__getNoticeInput) GetNoticeIndex() int { return v.NoticeIndex }
+
+// GetInputIndex returns __getNoticeInput.InputIndex, and is useful for accessing the field via an interface.
+func (v *__getNoticeInput) GetInputIndex() int { return v.InputIndex }
+
+// __getNoticesInput is used internally by genqlient
+type __getNoticesInput struct {
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetFirst returns __getNoticesInput.First, and is useful for accessing the field via an interface.
+func (v *__getNoticesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __getNoticesInput.After, and is useful for accessing the field via an interface.
+func (v *__getNoticesInput) GetAfter() *string { return v.After }
+
+// __getReportsInput is used internally by genqlient
+type __getReportsInput struct {
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetFirst returns __getReportsInput.First, and is useful for accessing the field via an interface.
+func (v *__getReportsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __getReportsInput.After, and is useful for accessing the field via an interface.
+func (v *__getReportsInput) GetAfter() *string { return v.After }
+
+// __getVoucherInput is used internally by genqlient
+type __getVoucherInput struct {
+	VoucherIndex int `json:"voucherIndex"`
+	InputIndex   int `json:"inputIndex"`
+}
+
+// GetVoucherIndex returns __getVoucherInput.VoucherIndex, and is useful for accessing the field via an interface.
+func (v *__getVoucherInput) GetVoucherIndex() int { return v.VoucherIndex }
+
+// GetInputIndex returns __getVoucherInput.InputIndex, and is useful for accessing the field via an interface.
+func (v *__getVoucherInput) GetInputIndex() int { return v.InputIndex }
+
+// __getVouchersInput is used internally by genqlient
+type __getVouchersInput struct {
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetFirst returns __getVouchersInput.First, and is useful for accessing the field via an interface.
+func (v *__getVouchersInput) GetFirst() int { return v.First }
+
+// GetAfter returns __getVouchersInput.After, and is useful for accessing the field via an interface.
+func (v *__getVouchersInput) GetAfter() *string { return v.After }
+
 // getInputInput includes the requested fields of the GraphQL type Input.
 // The GraphQL type's documentation follows.
 //
 // Request submitted to the application to advance its state
 type getInputInput struct {
-	// Status of the input
-	Status CompletionStatus `json:"status"`
-	// Input payload in Ethereum hex binary format, starting with '0x'
-	Payload string `json:"payload"`
-	// Address responsible for submitting the input
-	MsgSender string `json:"msgSender"`
-	// Timestamp associated with the input submission, as defined by the base layer's block in which it was recorded
-	Timestamp string `json:"timestamp"`
-	// Number of the base layer block in which the input was recorded
-	BlockNumber string `json:"blockNumber"`
-	// Get vouchers from this particular input with support for pagination
-	Vouchers getInputInputVouchersVoucherConnection `json:"vouchers"`
-	// Get notices from this particular input with support for pagination
-	Notices getInputInputNoticesNoticeConnection `json:"notices"`
-	// Get reports from this particular input with support for pagination
-	Reports getInputInputReportsReportConnection `json:"reports"`
+	inputFields `json:"-"`
 }
 
+// GetIndex returns getInputInput.Index, and is useful for accessing the field via an interface.
+func (v *getInputInput) GetIndex() int { return v.inputFields.Index }
+
 // GetStatus returns getInputInput.Status, and is useful for accessing the field via an interface.
-func (v *getInputInput) GetStatus() CompletionStatus { return v.Status }
+func (v *getInputInput) GetStatus() CompletionStatus { return v.inputFields.Status }
 
 // GetPayload returns getInputInput.Payload, and is useful for accessing the field via an interface.
-func (v *getInputInput) GetPayload() string { return v.Payload }
+func (v *getInputInput) GetPayload() string { return v.inputFields.Payload }
 
 // GetMsgSender returns getInputInput.MsgSender, and is useful for accessing the field via an interface.
-func (v *getInputInput) GetMsgSender() string { return v.MsgSender }
+func (v *getInputInput) GetMsgSender() string { return v.inputFields.MsgSender }
 
 // GetTimestamp returns getInputInput.Timestamp, and is useful for accessing the field via an interface.
-func (v *getInputInput) GetTimestamp() string { return v.Timestamp }
+func (v *getInputInput) GetTimestamp() string { return v.inputFields.Timestamp }
 
 // GetBlockNumber returns getInputInput.BlockNumber, and is useful for accessing the field via an interface.
-func (v *getInputInput) GetBlockNumber() string { return v.BlockNumber }
+func (v *getInputInput) GetBlockNumber() string { return v.inputFields.BlockNumber }
 
 // GetVouchers returns getInputInput.Vouchers, and is useful for accessing the field via an interface.
-func (v *getInputInput) GetVouchers() getInputInputVouchersVoucherConnection { return v.Vouchers }
+func (v *getInputInput) GetVouchers() inputFieldsVouchersVoucherConnection {
+	return v.inputFields.Vouchers
+}
 
 // GetNotices returns getInputInput.Notices, and is useful for accessing the field via an interface.
-func (v *getInputInput) GetNotices() getInputInputNoticesNoticeConnection { return v.Notices }
+func (v *getInputInput) GetNotices() inputFieldsNoticesNoticeConnection { return v.inputFields.Notices }
 
 // GetReports returns getInputInput.Reports, and is useful for accessing the field via an interface.
-func (v *getInputInput) GetReports() getInputInputReportsReportConnection { return v.Reports }
+func (v *getInputInput) GetReports() inputFieldsReportsReportConnection { return v.inputFields.Reports }
 
-// getInputInputNoticesNoticeConnection includes the requested fields of the GraphQL type NoticeConnection.
-// The GraphQL type's documentation follows.
-//
-// Pagination result
-type getInputInputNoticesNoticeConnection struct {
-	// Pagination entries returned for the current page
-	Edges []getInputInputNoticesNoticeConnectionEdgesNoticeEdge `json:"edges"`
+func (v *getInputInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInputInput
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInputInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.inputFields)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetEdges returns getInputInputNoticesNoticeConnection.Edges, and is useful for accessing the field via an interface.
-func (v *getInputInputNoticesNoticeConnection) GetEdges() []getInputInputNoticesNoticeConnectionEdgesNoticeEdge {
-	return v.Edges
+type __premarshalgetInputInput struct {
+	Index int `json:"index"`
+
+	Status CompletionStatus `json:"status"`
+
+	Payload string `json:"payload"`
+
+	MsgSender string `json:"msgSender"`
+
+	Timestamp string `json:"timestamp"`
+
+	BlockNumber string `json:"blockNumber"`
+
+	Vouchers inputFieldsVouchersVoucherConnection `json:"vouchers"`
+
+	Notices inputFieldsNoticesNoticeConnection `json:"notices"`
+
+	Reports inputFieldsReportsReportConnection `json:"reports"`
+}
+
+func (v *getInputInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInputInput) __premarshalJSON() (*__premarshalgetInputInput, error) {
+	var retval __premarshalgetInputInput
+
+	retval.Index = v.inputFields.Index
+	retval.Status = v.inputFields.Status
+	retval.Payload = v.inputFields.Payload
+	retval.MsgSender = v.inputFields.MsgSender
+	retval.Timestamp = v.inputFields.Timestamp
+	retval.BlockNumber = v.inputFields.BlockNumber
+	retval.Vouchers = v.inputFields.Vouchers
+	retval.Notices = v.inputFields.Notices
+	retval.Reports = v.inputFields.Reports
+	return &retval, nil
 }
 
-// getInputInputNoticesNoticeConnectionEdgesNoticeEdge includes the requested fields of the GraphQL type NoticeEdge.
+// getInputNoticesInput includes the requested fields of the GraphQL type Input.
 // The GraphQL type's documentation follows.
 //
-// Pagination entry
-type getInputInputNoticesNoticeConnectionEdgesNoticeEdge struct {
-	// Node instance
-	Node getInputInputNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice `json:"node"`
+// Request submitted to the application to advance its state
+type getInputNoticesInput struct {
+	// Get notices from this particular input with support for pagination
+	Notices getInputNoticesInputNoticesNoticeConnection `json:"notices"`
 }
 
-// GetNode returns getInputInputNoticesNoticeConnectionEdgesNoticeEdge.Node, and is useful for accessing the field via an interface.
-func (v *getInputInputNoticesNoticeConnectionEdgesNoticeEdge) GetNode() getInputInputNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice {
-	return v.Node
+// GetNotices returns getInputNoticesInput.Notices, and is useful for accessing the field via an interface.
+func (v *getInputNoticesInput) GetNotices() getInputNoticesInputNoticesNoticeConnection {
+	return v.Notices
 }
 
-// getInputInputNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice includes the requested fields of the GraphQL type Notice.
+// getInputNoticesInputNoticesNoticeConnection includes the requested fields of the GraphQL type NoticeConnection.
 // The GraphQL type's documentation follows.
 //
-// Informational statement that can be validated in the base layer blockchain
-type getInputInputNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice struct {
-	// Notice index within the context of the input that produced it
-	Index int `json:"index"`
-	// Notice data as a payload in Ethereum hex binary format, starting with '0x'
-	Payload string `json:"payload"`
+// Pagination result
+type getInputNoticesInputNoticesNoticeConnection struct {
+	noticeConnectionFields `json:"-"`
 }
 
-// GetIndex returns getInputInputNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice.Index, and is useful for accessing the field via an interface.
-func (v *getInputInputNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice) GetIndex() int {
-	return v.Index
+// GetEdges returns getInputNoticesInputNoticesNoticeConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getInputNoticesInputNoticesNoticeConnection) GetEdges() []noticeConnectionFieldsEdgesNoticeEdge {
+	return v.noticeConnectionFields.Edges
+}
+
+// GetPageInfo returns getInputNoticesInputNoticesNoticeConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getInputNoticesInputNoticesNoticeConnection) GetPageInfo() noticeConnectionFieldsPageInfo {
+	return v.noticeConnectionFields.PageInfo
+}
+
+func (v *getInputNoticesInputNoticesNoticeConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInputNoticesInputNoticesNoticeConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInputNoticesInputNoticesNoticeConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.noticeConnectionFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetInputNoticesInputNoticesNoticeConnection struct {
+	Edges []noticeConnectionFieldsEdgesNoticeEdge `json:"edges"`
+
+	PageInfo noticeConnectionFieldsPageInfo `json:"pageInfo"`
+}
+
+func (v *getInputNoticesInputNoticesNoticeConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInputNoticesInputNoticesNoticeConnection) __premarshalJSON() (*__premarshalgetInputNoticesInputNoticesNoticeConnection, error) {
+	var retval __premarshalgetInputNoticesInputNoticesNoticeConnection
+
+	retval.Edges = v.noticeConnectionFields.Edges
+	retval.PageInfo = v.noticeConnectionFields.PageInfo
+	return &retval, nil
+}
+
+// getInputNoticesResponse is returned by getInputNotices on success.
+type getInputNoticesResponse struct {
+	// Get input based on its identifier
+	Input getInputNoticesInput `json:"input"`
 }
 
-// GetPayload returns getInputInputNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice.Payload, and is useful for accessing the field via an interface.
-func (v *getInputInputNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice) GetPayload() string {
-	return v.Payload
+// GetInput returns getInputNoticesResponse.Input, and is useful for accessing the field via an interface.
+func (v *getInputNoticesResponse) GetInput() getInputNoticesInput { return v.Input }
+
+// getInputReportsInput includes the requested fields of the GraphQL type Input.
+// The GraphQL type's documentation follows.
+//
+// Request submitted to the application to advance its state
+type getInputReportsInput struct {
+	// Get reports from this particular input with support for pagination
+	Reports getInputReportsInputReportsReportConnection `json:"reports"`
+}
+
+// GetReports returns getInputReportsInput.Reports, and is useful for accessing the field via an interface.
+func (v *getInputReportsInput) GetReports() getInputReportsInputReportsReportConnection {
+	return v.Reports
 }
 
-// getInputInputReportsReportConnection includes the requested fields of the GraphQL type ReportConnection.
+// getInputReportsInputReportsReportConnection includes the requested fields of the GraphQL type ReportConnection.
 // The GraphQL type's documentation follows.
 //
 // Pagination result
-type getInputInputReportsReportConnection struct {
-	// Pagination entries returned for the current page
-	Edges []getInputInputReportsReportConnectionEdgesReportEdge `json:"edges"`
+type getInputReportsInputReportsReportConnection struct {
+	reportConnectionFields `json:"-"`
 }
 
-// GetEdges returns getInputInputReportsReportConnection.Edges, and is useful for accessing the field via an interface.
-func (v *getInputInputReportsReportConnection) GetEdges() []getInputInputReportsReportConnectionEdgesReportEdge {
-	return v.Edges
+// GetEdges returns getInputReportsInputReportsReportConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getInputReportsInputReportsReportConnection) GetEdges() []reportConnectionFieldsEdgesReportEdge {
+	return v.reportConnectionFields.Edges
+}
+
+// GetPageInfo returns getInputReportsInputReportsReportConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getInputReportsInputReportsReportConnection) GetPageInfo() reportConnectionFieldsPageInfo {
+	return v.reportConnectionFields.PageInfo
+}
+
+func (v *getInputReportsInputReportsReportConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInputReportsInputReportsReportConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInputReportsInputReportsReportConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.reportConnectionFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetInputReportsInputReportsReportConnection struct {
+	Edges []reportConnectionFieldsEdgesReportEdge `json:"edges"`
+
+	PageInfo reportConnectionFieldsPageInfo `json:"pageInfo"`
+}
+
+func (v *getInputReportsInputReportsReportConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInputReportsInputReportsReportConnection) __premarshalJSON() (*__premarshalgetInputReportsInputReportsReportConnection, error) {
+	var retval __premarshalgetInputReportsInputReportsReportConnection
+
+	retval.Edges = v.reportConnectionFields.Edges
+	retval.PageInfo = v.reportConnectionFields.PageInfo
+	return &retval, nil
+}
+
+// getInputReportsResponse is returned by getInputReports on success.
+type getInputReportsResponse struct {
+	// Get input based on its identifier
+	Input getInputReportsInput `json:"input"`
+}
+
+// GetInput returns getInputReportsResponse.Input, and is useful for accessing the field via an interface.
+func (v *getInputReportsResponse) GetInput() getInputReportsInput { return v.Input }
+
+// getInputResponse is returned by getInput on success.
+type getInputResponse struct {
+	// Get input based on its identifier
+	Input getInputInput `json:"input"`
 }
 
-// getInputInputReportsReportConnectionEdgesReportEdge includes the requested fields of the GraphQL type ReportEdge.
+// GetInput returns getInputResponse.Input, and is useful for accessing the field via an interface.
+func (v *getInputResponse) GetInput() getInputInput { return v.Input }
+
+// getInputVouchersInput includes the requested fields of the GraphQL type Input.
 // The GraphQL type's documentation follows.
 //
-// Pagination entry
-type getInputInputReportsReportConnectionEdgesReportEdge struct {
-	// Node instance
-	Node getInputInputReportsReportConnectionEdgesReportEdgeNodeReport `json:"node"`
+// Request submitted to the application to advance its state
+type getInputVouchersInput struct {
+	// Get vouchers from this particular input with support for pagination
+	Vouchers getInputVouchersInputVouchersVoucherConnection `json:"vouchers"`
 }
 
-// GetNode returns getInputInputReportsReportConnectionEdgesReportEdge.Node, and is useful for accessing the field via an interface.
-func (v *getInputInputReportsReportConnectionEdgesReportEdge) GetNode() getInputInputReportsReportConnectionEdgesReportEdgeNodeReport {
-	return v.Node
+// GetVouchers returns getInputVouchersInput.Vouchers, and is useful for accessing the field via an interface.
+func (v *getInputVouchersInput) GetVouchers() getInputVouchersInputVouchersVoucherConnection {
+	return v.Vouchers
 }
 
-// getInputInputReportsReportConnectionEdgesReportEdgeNodeReport includes the requested fields of the GraphQL type Report.
+// getInputVouchersInputVouchersVoucherConnection includes the requested fields of the GraphQL type VoucherConnection.
 // The GraphQL type's documentation follows.
 //
-// Application log or diagnostic information
-type getInputInputReportsReportConnectionEdgesReportEdgeNodeReport struct {
-	// Report index within the context of the input that produced it
-	Index int `json:"index"`
-	// Report data as a payload in Ethereum hex binary format, starting with '0x'
-	Payload string `json:"payload"`
+// Pagination result
+type getInputVouchersInputVouchersVoucherConnection struct {
+	voucherConnectionFields `json:"-"`
 }
 
-// GetIndex returns getInputInputReportsReportConnectionEdgesReportEdgeNodeReport.Index, and is useful for accessing the field via an interface.
-func (v *getInputInputReportsReportConnectionEdgesReportEdgeNodeReport) GetIndex() int {
-	return v.Index
+// GetEdges returns getInputVouchersInputVouchersVoucherConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getInputVouchersInputVouchersVoucherConnection) GetEdges() []voucherConnectionFieldsEdgesVoucherEdge {
+	return v.voucherConnectionFields.Edges
+}
+
+// GetPageInfo returns getInputVouchersInputVouchersVoucherConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getInputVouchersInputVouchersVoucherConnection) GetPageInfo() voucherConnectionFieldsPageInfo {
+	return v.voucherConnectionFields.PageInfo
+}
+
+func (v *getInputVouchersInputVouchersVoucherConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInputVouchersInputVouchersVoucherConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInputVouchersInputVouchersVoucherConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.voucherConnectionFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetInputVouchersInputVouchersVoucherConnection struct {
+	Edges []voucherConnectionFieldsEdgesVoucherEdge `json:"edges"`
+
+	PageInfo voucherConnectionFieldsPageInfo `json:"pageInfo"`
+}
+
+func (v *getInputVouchersInputVouchersVoucherConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInputVouchersInputVouchersVoucherConnection) __premarshalJSON() (*__premarshalgetInputVouchersInputVouchersVoucherConnection, error) {
+	var retval __premarshalgetInputVouchersInputVouchersVoucherConnection
+
+	retval.Edges = v.voucherConnectionFields.Edges
+	retval.PageInfo = v.voucherConnectionFields.PageInfo
+	return &retval, nil
 }
 
-// GetPayload returns getInputInputReportsReportConnectionEdgesReportEdgeNodeReport.Payload, and is useful for accessing the field via an interface.
-func (v *getInputInputReportsReportConnectionEdgesReportEdgeNodeReport) GetPayload() string {
-	return v.Payload
+// getInputVouchersResponse is returned by getInputVouchers on success.
+type getInputVouchersResponse struct {
+	// Get input based on its identifier
+	Input getInputVouchersInput `json:"input"`
 }
 
-// getInputInputVouchersVoucherConnection includes the requested fields of the GraphQL type VoucherConnection.
+// GetInput returns getInputVouchersResponse.Input, and is useful for accessing the field via an interface.
+func (v *getInputVouchersResponse) GetInput() getInputVouchersInput { return v.Input }
+
+// getInputsInputsInputConnection includes the requested fields of the GraphQL type InputConnection.
 // The GraphQL type's documentation follows.
 //
 // Pagination result
-type getInputInputVouchersVoucherConnection struct {
+type getInputsInputsInputConnection struct {
 	// Pagination entries returned for the current page
-	Edges []getInputInputVouchersVoucherConnectionEdgesVoucherEdge `json:"edges"`
+	Edges []getInputsInputsInputConnectionEdgesInputEdge `json:"edges"`
+	// Pagination metadata
+	PageInfo getInputsInputsInputConnectionPageInfo `json:"pageInfo"`
 }
 
-// GetEdges returns getInputInputVouchersVoucherConnection.Edges, and is useful for accessing the field via an interface.
-func (v *getInputInputVouchersVoucherConnection) GetEdges() []getInputInputVouchersVoucherConnectionEdgesVoucherEdge {
+// GetEdges returns getInputsInputsInputConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnection) GetEdges() []getInputsInputsInputConnectionEdgesInputEdge {
 	return v.Edges
 }
 
-// getInputInputVouchersVoucherConnectionEdgesVoucherEdge includes the requested fields of the GraphQL type VoucherEdge.
+// GetPageInfo returns getInputsInputsInputConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnection) GetPageInfo() getInputsInputsInputConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getInputsInputsInputConnectionEdgesInputEdge includes the requested fields of the GraphQL type InputEdge.
 // The GraphQL type's documentation follows.
 //
 // Pagination entry
-type getInputInputVouchersVoucherConnectionEdgesVoucherEdge struct {
+type getInputsInputsInputConnectionEdgesInputEdge struct {
 	// Node instance
-	Node getInputInputVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher `json:"node"`
+	Node getInputsInputsInputConnectionEdgesInputEdgeNodeInput `json:"node"`
 }
 
-// GetNode returns getInputInputVouchersVoucherConnectionEdgesVoucherEdge.Node, and is useful for accessing the field via an interface.
-func (v *getInputInputVouchersVoucherConnectionEdgesVoucherEdge) GetNode() getInputInputVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher {
+// GetNode returns getInputsInputsInputConnectionEdgesInputEdge.Node, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionEdgesInputEdge) GetNode() getInputsInputsInputConnectionEdgesInputEdgeNodeInput {
 	return v.Node
 }
 
-// getInputInputVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher includes the requested fields of the GraphQL type Voucher.
+// getInputsInputsInputConnectionEdgesInputEdgeNodeInput includes the requested fields of the GraphQL type Input.
 // The GraphQL type's documentation follows.
 //
-// Representation of a transaction that can be carried out on the base layer blockchain, such as a transfer of assets
-type getInputInputVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher struct {
-	// Voucher index within the context of the input that produced it
-	Index int `json:"index"`
-	// Transaction destination address in Ethereum hex binary format (20 bytes), starting with '0x'
-	Destination string `json:"destination"`
-	// Transaction payload in Ethereum hex binary format, starting with '0x'
-	Payload string `json:"payload"`
+// Request submitted to the application to advance its state
+type getInputsInputsInputConnectionEdgesInputEdgeNodeInput struct {
+	inputFields `json:"-"`
 }
 
-// GetIndex returns getInputInputVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher.Index, and is useful for accessing the field via an interface.
-func (v *getInputInputVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher) GetIndex() int {
-	return v.Index
+// GetIndex returns getInputsInputsInputConnectionEdgesInputEdgeNodeInput.Index, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) GetIndex() int {
+	return v.inputFields.Index
 }
 
-// GetDestination returns getInputInputVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher.Destination, and is useful for accessing the field via an interface.
-func (v *getInputInputVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher) GetDestination() string {
-	return v.Destination
+// GetStatus returns getInputsInputsInputConnectionEdgesInputEdgeNodeInput.Status, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) GetStatus() CompletionStatus {
+	return v.inputFields.Status
 }
 
-// GetPayload returns getInputInputVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher.Payload, and is useful for accessing the field via an interface.
-func (v *getInputInputVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher) GetPayload() string {
-	return v.Payload
+// GetPayload returns getInputsInputsInputConnectionEdgesInputEdgeNodeInput.Payload, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) GetPayload() string {
+	return v.inputFields.Payload
 }
 
-// getInputResponse is returned by getInput on success.
-type getInputResponse struct {
-	// Get input based on its identifier
-	Input getInputInput `json:"input"`
+// GetMsgSender returns getInputsInputsInputConnectionEdgesInputEdgeNodeInput.MsgSender, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) GetMsgSender() string {
+	return v.inputFields.MsgSender
 }
 
-// GetInput returns getInputResponse.Input, and is useful for accessing the field via an interface.
-func (v *getInputResponse) GetInput() getInputInput { return v.Input }
+// GetTimestamp returns getInputsInputsInputConnectionEdgesInputEdgeNodeInput.Timestamp, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) GetTimestamp() string {
+	return v.inputFields.Timestamp
+}
 
-// The query or mutation executed by getInput.
-const getInput_Operation = `
-query getInput ($inputIndex: Int!) {
-	input(index: $inputIndex) {
-		status
-		payload
-		msgSender
-		timestamp
-		blockNumber
-		vouchers {
-			edges {
-				node {
-					index
-					destination
-					payload
-				}
-			}
-		}
-		notices {
-			edges {
-				node {
-					index
-					payload
-				}
-			}
-		}
-		reports {
-			edges {
-				node {
-					index
-					payload
-				}
-			}
-		}
-	}
+// GetBlockNumber returns getInputsInputsInputConnectionEdgesInputEdgeNodeInput.BlockNumber, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) GetBlockNumber() string {
+	return v.inputFields.BlockNumber
 }
-`
 
-func getInput(
-	ctx context.Context,
-	client graphql.Client,
-	inputIndex int,
-) (*getInputResponse, error) {
-	req := &graphql.Request{
-		OpName: "getInput",
-		Query:  getInput_Operation,
-		Variables: &__getInputInput{
-			InputIndex: inputIndex,
-		},
-	}
-	var err error
+// GetVouchers returns getInputsInputsInputConnectionEdgesInputEdgeNodeInput.Vouchers, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) GetVouchers() inputFieldsVouchersVoucherConnection {
+	return v.inputFields.Vouchers
+}
+
+// GetNotices returns getInputsInputsInputConnectionEdgesInputEdgeNodeInput.Notices, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) GetNotices() inputFieldsNoticesNoticeConnection {
+	return v.inputFields.Notices
+}
+
+// GetReports returns getInputsInputsInputConnectionEdgesInputEdgeNodeInput.Reports, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) GetReports() inputFieldsReportsReportConnection {
+	return v.inputFields.Reports
+}
+
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getInputsInputsInputConnectionEdgesInputEdgeNodeInput
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getInputsInputsInputConnectionEdgesInputEdgeNodeInput = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.inputFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetInputsInputsInputConnectionEdgesInputEdgeNodeInput struct {
+	Index int `json:"index"`
+
+	Status CompletionStatus `json:"status"`
+
+	Payload string `json:"payload"`
+
+	MsgSender string `json:"msgSender"`
+
+	Timestamp string `json:"timestamp"`
+
+	BlockNumber string `json:"blockNumber"`
+
+	Vouchers inputFieldsVouchersVoucherConnection `json:"vouchers"`
+
+	Notices inputFieldsNoticesNoticeConnection `json:"notices"`
+
+	Reports inputFieldsReportsReportConnection `json:"reports"`
+}
+
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getInputsInputsInputConnectionEdgesInputEdgeNodeInput) __premarshalJSON() (*__premarshalgetInputsInputsInputConnectionEdgesInputEdgeNodeInput, error) {
+	var retval __premarshalgetInputsInputsInputConnectionEdgesInputEdgeNodeInput
+
+	retval.Index = v.inputFields.Index
+	retval.Status = v.inputFields.Status
+	retval.Payload = v.inputFields.Payload
+	retval.MsgSender = v.inputFields.MsgSender
+	retval.Timestamp = v.inputFields.Timestamp
+	retval.BlockNumber = v.inputFields.BlockNumber
+	retval.Vouchers = v.inputFields.Vouchers
+	retval.Notices = v.inputFields.Notices
+	retval.Reports = v.inputFields.Reports
+	return &retval, nil
+}
+
+// getInputsInputsInputConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type getInputsInputsInputConnectionPageInfo struct {
+	// Cursor pointing to the last entry of the page
+	EndCursor *string `json:"endCursor"`
+	// Indicates if there are additional entries after the end curs
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns getInputsInputsInputConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionPageInfo) GetEndCursor() *string { return v.EndCursor }
+
+// GetHasNextPage returns getInputsInputsInputConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getInputsInputsInputConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// getInputsResponse is returned by getInputs on success.
+type getInputsResponse struct {
+	// Get inputs with support for pagination
+	Inputs getInputsInputsInputConnection `json:"inputs"`
+}
+
+// GetInputs returns getInputsResponse.Inputs, and is useful for accessing the field via an interface.
+func (v *getInputsResponse) GetInputs() getInputsInputsInputConnection { return v.Inputs }
+
+// getNoticeNotice includes the requested fields of the GraphQL type Notice.
+// The GraphQL type's documentation follows.
+//
+// Informational statement that can be validated in the base layer blockchain
+type getNoticeNotice struct {
+	// Notice index within the context of the input that produced it
+	Index int `json:"index"`
+	// Notice data as a payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+	// Proof object that allows this notice to be validated by the base layer blockchain
+	Proof *getNoticeNoticeProof `json:"proof"`
+}
+
+// GetIndex returns getNoticeNotice.Index, and is useful for accessing the field via an interface.
+func (v *getNoticeNotice) GetIndex() int { return v.Index }
+
+// GetPayload returns getNoticeNotice.Payload, and is useful for accessing the field via an interface.
+func (v *getNoticeNotice) GetPayload() string { return v.Payload }
+
+// GetProof returns getNoticeNotice.Proof, and is useful for accessing the field via an interface.
+func (v *getNoticeNotice) GetProof() *getNoticeNoticeProof { return v.Proof }
+
+// getNoticeNoticeProof includes the requested fields of the GraphQL type Proof.
+// The GraphQL type's documentation follows.
+//
+// Data that can be used as proof to validate notices and execute vouchers on the base layer blockchain
+type getNoticeNoticeProof struct {
+	proofFields `json:"-"`
+}
+
+// GetValidity returns getNoticeNoticeProof.Validity, and is useful for accessing the field via an interface.
+func (v *getNoticeNoticeProof) GetValidity() proofFieldsValidityOutputValidityProof {
+	return v.proofFields.Validity
+}
+
+// GetContext returns getNoticeNoticeProof.Context, and is useful for accessing the field via an interface.
+func (v *getNoticeNoticeProof) GetContext() string { return v.proofFields.Context }
+
+func (v *getNoticeNoticeProof) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getNoticeNoticeProof
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getNoticeNoticeProof = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.proofFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetNoticeNoticeProof struct {
+	Validity proofFieldsValidityOutputValidityProof `json:"validity"`
+
+	Context string `json:"context"`
+}
+
+func (v *getNoticeNoticeProof) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getNoticeNoticeProof) __premarshalJSON() (*__premarshalgetNoticeNoticeProof, error) {
+	var retval __premarshalgetNoticeNoticeProof
+
+	retval.Validity = v.proofFields.Validity
+	retval.Context = v.proofFields.Context
+	return &retval, nil
+}
+
+// getNoticeResponse is returned by getNotice on success.
+type getNoticeResponse struct {
+	// Get notice based on its index
+	Notice getNoticeNotice `json:"notice"`
+}
+
+// GetNotice returns getNoticeResponse.Notice, and is useful for accessing the field via an interface.
+func (v *getNoticeResponse) GetNotice() getNoticeNotice { return v.Notice }
+
+// getNoticesNoticesNoticeConnection includes the requested fields of the GraphQL type NoticeConnection.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type getNoticesNoticesNoticeConnection struct {
+	// Pagination entries returned for the current page
+	Edges []getNoticesNoticesNoticeConnectionEdgesNoticeEdge `json:"edges"`
+	// Pagination metadata
+	PageInfo getNoticesNoticesNoticeConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns getNoticesNoticesNoticeConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getNoticesNoticesNoticeConnection) GetEdges() []getNoticesNoticesNoticeConnectionEdgesNoticeEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns getNoticesNoticesNoticeConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getNoticesNoticesNoticeConnection) GetPageInfo() getNoticesNoticesNoticeConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getNoticesNoticesNoticeConnectionEdgesNoticeEdge includes the requested fields of the GraphQL type NoticeEdge.
+// The GraphQL type's documentation follows.
+//
+// Pagination entry
+type getNoticesNoticesNoticeConnectionEdgesNoticeEdge struct {
+	// Node instance
+	Node getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice `json:"node"`
+}
+
+// GetNode returns getNoticesNoticesNoticeConnectionEdgesNoticeEdge.Node, and is useful for accessing the field via an interface.
+func (v *getNoticesNoticesNoticeConnectionEdgesNoticeEdge) GetNode() getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice {
+	return v.Node
+}
+
+// getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice includes the requested fields of the GraphQL type Notice.
+// The GraphQL type's documentation follows.
+//
+// Informational statement that can be validated in the base layer blockchain
+type getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice struct {
+	noticeFields `json:"-"`
+	// Input whose processing produced the notice
+	Input getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNoticeInput `json:"input"`
+}
+
+// GetInput returns getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice.Input, and is useful for accessing the field via an interface.
+func (v *getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice) GetInput() getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNoticeInput {
+	return v.Input
+}
+
+// GetIndex returns getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice.Index, and is useful for accessing the field via an interface.
+func (v *getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice) GetIndex() int {
+	return v.noticeFields.Index
+}
+
+// GetPayload returns getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice.Payload, and is useful for accessing the field via an interface.
+func (v *getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice) GetPayload() string {
+	return v.noticeFields.Payload
+}
+
+func (v *getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.noticeFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice struct {
+	Input getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNoticeInput `json:"input"`
+
+	Index int `json:"index"`
+
+	Payload string `json:"payload"`
+}
+
+func (v *getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice) __premarshalJSON() (*__premarshalgetNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice, error) {
+	var retval __premarshalgetNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNotice
+
+	retval.Input = v.Input
+	retval.Index = v.noticeFields.Index
+	retval.Payload = v.noticeFields.Payload
+	return &retval, nil
+}
+
+// getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNoticeInput includes the requested fields of the GraphQL type Input.
+// The GraphQL type's documentation follows.
+//
+// Request submitted to the application to advance its state
+type getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNoticeInput struct {
+	// Input index starting from genesis
+	Index int `json:"index"`
+	// Address responsible for submitting the input
+	MsgSender string `json:"msgSender"`
+}
+
+// GetIndex returns getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNoticeInput.Index, and is useful for accessing the field via an interface.
+func (v *getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNoticeInput) GetIndex() int {
+	return v.Index
+}
+
+// GetMsgSender returns getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNoticeInput.MsgSender, and is useful for accessing the field via an interface.
+func (v *getNoticesNoticesNoticeConnectionEdgesNoticeEdgeNodeNoticeInput) GetMsgSender() string {
+	return v.MsgSender
+}
+
+// getNoticesNoticesNoticeConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type getNoticesNoticesNoticeConnectionPageInfo struct {
+	pageFields `json:"-"`
+}
+
+// GetEndCursor returns getNoticesNoticesNoticeConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getNoticesNoticesNoticeConnectionPageInfo) GetEndCursor() *string {
+	return v.pageFields.EndCursor
+}
+
+// GetHasNextPage returns getNoticesNoticesNoticeConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getNoticesNoticesNoticeConnectionPageInfo) GetHasNextPage() bool {
+	return v.pageFields.HasNextPage
+}
+
+func (v *getNoticesNoticesNoticeConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getNoticesNoticesNoticeConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getNoticesNoticesNoticeConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.pageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetNoticesNoticesNoticeConnectionPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *getNoticesNoticesNoticeConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getNoticesNoticesNoticeConnectionPageInfo) __premarshalJSON() (*__premarshalgetNoticesNoticesNoticeConnectionPageInfo, error) {
+	var retval __premarshalgetNoticesNoticesNoticeConnectionPageInfo
+
+	retval.EndCursor = v.pageFields.EndCursor
+	retval.HasNextPage = v.pageFields.HasNextPage
+	return &retval, nil
+}
+
+// getNoticesResponse is returned by getNotices on success.
+type getNoticesResponse struct {
+	// Get notices with support for pagination
+	Notices getNoticesNoticesNoticeConnection `json:"notices"`
+}
+
+// GetNotices returns getNoticesResponse.Notices, and is useful for accessing the field via an interface.
+func (v *getNoticesResponse) GetNotices() getNoticesNoticesNoticeConnection { return v.Notices }
+
+// getReportsReportsReportConnection includes the requested fields of the GraphQL type ReportConnection.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type getReportsReportsReportConnection struct {
+	// Pagination entries returned for the current page
+	Edges []getReportsReportsReportConnectionEdgesReportEdge `json:"edges"`
+	// Pagination metadata
+	PageInfo getReportsReportsReportConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns getReportsReportsReportConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getReportsReportsReportConnection) GetEdges() []getReportsReportsReportConnectionEdgesReportEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns getReportsReportsReportConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getReportsReportsReportConnection) GetPageInfo() getReportsReportsReportConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getReportsReportsReportConnectionEdgesReportEdge includes the requested fields of the GraphQL type ReportEdge.
+// The GraphQL type's documentation follows.
+//
+// Pagination entry
+type getReportsReportsReportConnectionEdgesReportEdge struct {
+	// Node instance
+	Node getReportsReportsReportConnectionEdgesReportEdgeNodeReport `json:"node"`
+}
+
+// GetNode returns getReportsReportsReportConnectionEdgesReportEdge.Node, and is useful for accessing the field via an interface.
+func (v *getReportsReportsReportConnectionEdgesReportEdge) GetNode() getReportsReportsReportConnectionEdgesReportEdgeNodeReport {
+	return v.Node
+}
+
+// getReportsReportsReportConnectionEdgesReportEdgeNodeReport includes the requested fields of the GraphQL type Report.
+// The GraphQL type's documentation follows.
+//
+// Application log or diagnostic information
+type getReportsReportsReportConnectionEdgesReportEdgeNodeReport struct {
+	reportFields `json:"-"`
+	// Input whose processing produced the report
+	Input getReportsReportsReportConnectionEdgesReportEdgeNodeReportInput `json:"input"`
+}
+
+// GetInput returns getReportsReportsReportConnectionEdgesReportEdgeNodeReport.Input, and is useful for accessing the field via an interface.
+func (v *getReportsReportsReportConnectionEdgesReportEdgeNodeReport) GetInput() getReportsReportsReportConnectionEdgesReportEdgeNodeReportInput {
+	return v.Input
+}
+
+// GetIndex returns getReportsReportsReportConnectionEdgesReportEdgeNodeReport.Index, and is useful for accessing the field via an interface.
+func (v *getReportsReportsReportConnectionEdgesReportEdgeNodeReport) GetIndex() int {
+	return v.reportFields.Index
+}
+
+// GetPayload returns getReportsReportsReportConnectionEdgesReportEdgeNodeReport.Payload, and is useful for accessing the field via an interface.
+func (v *getReportsReportsReportConnectionEdgesReportEdgeNodeReport) GetPayload() string {
+	return v.reportFields.Payload
+}
+
+func (v *getReportsReportsReportConnectionEdgesReportEdgeNodeReport) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getReportsReportsReportConnectionEdgesReportEdgeNodeReport
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getReportsReportsReportConnectionEdgesReportEdgeNodeReport = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.reportFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetReportsReportsReportConnectionEdgesReportEdgeNodeReport struct {
+	Input getReportsReportsReportConnectionEdgesReportEdgeNodeReportInput `json:"input"`
+
+	Index int `json:"index"`
+
+	Payload string `json:"payload"`
+}
+
+func (v *getReportsReportsReportConnectionEdgesReportEdgeNodeReport) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getReportsReportsReportConnectionEdgesReportEdgeNodeReport) __premarshalJSON() (*__premarshalgetReportsReportsReportConnectionEdgesReportEdgeNodeReport, error) {
+	var retval __premarshalgetReportsReportsReportConnectionEdgesReportEdgeNodeReport
+
+	retval.Input = v.Input
+	retval.Index = v.reportFields.Index
+	retval.Payload = v.reportFields.Payload
+	return &retval, nil
+}
+
+// getReportsReportsReportConnectionEdgesReportEdgeNodeReportInput includes the requested fields of the GraphQL type Input.
+// The GraphQL type's documentation follows.
+//
+// Request submitted to the application to advance its state
+type getReportsReportsReportConnectionEdgesReportEdgeNodeReportInput struct {
+	// Input index starting from genesis
+	Index int `json:"index"`
+	// Address responsible for submitting the input
+	MsgSender string `json:"msgSender"`
+}
+
+// GetIndex returns getReportsReportsReportConnectionEdgesReportEdgeNodeReportInput.Index, and is useful for accessing the field via an interface.
+func (v *getReportsReportsReportConnectionEdgesReportEdgeNodeReportInput) GetIndex() int {
+	return v.Index
+}
+
+// GetMsgSender returns getReportsReportsReportConnectionEdgesReportEdgeNodeReportInput.MsgSender, and is useful for accessing the field via an interface.
+func (v *getReportsReportsReportConnectionEdgesReportEdgeNodeReportInput) GetMsgSender() string {
+	return v.MsgSender
+}
+
+// getReportsReportsReportConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type getReportsReportsReportConnectionPageInfo struct {
+	pageFields `json:"-"`
+}
+
+// GetEndCursor returns getReportsReportsReportConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getReportsReportsReportConnectionPageInfo) GetEndCursor() *string {
+	return v.pageFields.EndCursor
+}
+
+// GetHasNextPage returns getReportsReportsReportConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getReportsReportsReportConnectionPageInfo) GetHasNextPage() bool {
+	return v.pageFields.HasNextPage
+}
+
+func (v *getReportsReportsReportConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getReportsReportsReportConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getReportsReportsReportConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.pageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetReportsReportsReportConnectionPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *getReportsReportsReportConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getReportsReportsReportConnectionPageInfo) __premarshalJSON() (*__premarshalgetReportsReportsReportConnectionPageInfo, error) {
+	var retval __premarshalgetReportsReportsReportConnectionPageInfo
+
+	retval.EndCursor = v.pageFields.EndCursor
+	retval.HasNextPage = v.pageFields.HasNextPage
+	return &retval, nil
+}
+
+// getReportsResponse is returned by getReports on success.
+type getReportsResponse struct {
+	// Get reports with support for pagination
+	Reports getReportsReportsReportConnection `json:"reports"`
+}
+
+// GetReports returns getReportsResponse.Reports, and is useful for accessing the field via an interface.
+func (v *getReportsResponse) GetReports() getReportsReportsReportConnection { return v.Reports }
+
+// getVoucherResponse is returned by getVoucher on success.
+type getVoucherResponse struct {
+	// Get voucher based on its index
+	Voucher getVoucherVoucher `json:"voucher"`
+}
+
+// GetVoucher returns getVoucherResponse.Voucher, and is useful for accessing the field via an interface.
+func (v *getVoucherResponse) GetVoucher() getVoucherVoucher { return v.Voucher }
+
+// getVoucherVoucher includes the requested fields of the GraphQL type Voucher.
+// The GraphQL type's documentation follows.
+//
+// Representation of a transaction that can be carried out on the base layer blockchain, such as a transfer of assets
+type getVoucherVoucher struct {
+	// Voucher index within the context of the input that produced it
+	Index int `json:"index"`
+	// Transaction destination address in Ethereum hex binary format (20 bytes), starting with '0x'
+	Destination string `json:"destination"`
+	// Transaction payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+	// Proof object that allows this voucher to be validated and executed on the base layer blockchain
+	Proof *getVoucherVoucherProof `json:"proof"`
+}
+
+// GetIndex returns getVoucherVoucher.Index, and is useful for accessing the field via an interface.
+func (v *getVoucherVoucher) GetIndex() int { return v.Index }
+
+// GetDestination returns getVoucherVoucher.Destination, and is useful for accessing the field via an interface.
+func (v *getVoucherVoucher) GetDestination() string { return v.Destination }
+
+// GetPayload returns getVoucherVoucher.Payload, and is useful for accessing the field via an interface.
+func (v *getVoucherVoucher) GetPayload() string { return v.Payload }
+
+// GetProof returns getVoucherVoucher.Proof, and is useful for accessing the field via an interface.
+func (v *getVoucherVoucher) GetProof() *getVoucherVoucherProof { return v.Proof }
+
+// getVoucherVoucherProof includes the requested fields of the GraphQL type Proof.
+// The GraphQL type's documentation follows.
+//
+// Data that can be used as proof to validate notices and execute vouchers on the base layer blockchain
+type getVoucherVoucherProof struct {
+	proofFields `json:"-"`
+}
+
+// GetValidity returns getVoucherVoucherProof.Validity, and is useful for accessing the field via an interface.
+func (v *getVoucherVoucherProof) GetValidity() proofFieldsValidityOutputValidityProof {
+	return v.proofFields.Validity
+}
+
+// GetContext returns getVoucherVoucherProof.Context, and is useful for accessing the field via an interface.
+func (v *getVoucherVoucherProof) GetContext() string { return v.proofFields.Context }
+
+func (v *getVoucherVoucherProof) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getVoucherVoucherProof
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getVoucherVoucherProof = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.proofFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetVoucherVoucherProof struct {
+	Validity proofFieldsValidityOutputValidityProof `json:"validity"`
+
+	Context string `json:"context"`
+}
+
+func (v *getVoucherVoucherProof) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getVoucherVoucherProof) __premarshalJSON() (*__premarshalgetVoucherVoucherProof, error) {
+	var retval __premarshalgetVoucherVoucherProof
+
+	retval.Validity = v.proofFields.Validity
+	retval.Context = v.proofFields.Context
+	return &retval, nil
+}
+
+// getVouchersResponse is returned by getVouchers on success.
+type getVouchersResponse struct {
+	// Get vouchers with support for pagination
+	Vouchers getVouchersVouchersVoucherConnection `json:"vouchers"`
+}
+
+// GetVouchers returns getVouchersResponse.Vouchers, and is useful for accessing the field via an interface.
+func (v *getVouchersResponse) GetVouchers() getVouchersVouchersVoucherConnection { return v.Vouchers }
+
+// getVouchersVouchersVoucherConnection includes the requested fields of the GraphQL type VoucherConnection.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type getVouchersVouchersVoucherConnection struct {
+	// Pagination entries returned for the current page
+	Edges []getVouchersVouchersVoucherConnectionEdgesVoucherEdge `json:"edges"`
+	// Pagination metadata
+	PageInfo getVouchersVouchersVoucherConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns getVouchersVouchersVoucherConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnection) GetEdges() []getVouchersVouchersVoucherConnectionEdgesVoucherEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns getVouchersVouchersVoucherConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnection) GetPageInfo() getVouchersVouchersVoucherConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getVouchersVouchersVoucherConnectionEdgesVoucherEdge includes the requested fields of the GraphQL type VoucherEdge.
+// The GraphQL type's documentation follows.
+//
+// Pagination entry
+type getVouchersVouchersVoucherConnectionEdgesVoucherEdge struct {
+	// Node instance
+	Node getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher `json:"node"`
+}
+
+// GetNode returns getVouchersVouchersVoucherConnectionEdgesVoucherEdge.Node, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnectionEdgesVoucherEdge) GetNode() getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher {
+	return v.Node
+}
+
+// getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher includes the requested fields of the GraphQL type Voucher.
+// The GraphQL type's documentation follows.
+//
+// Representation of a transaction that can be carried out on the base layer blockchain, such as a transfer of assets
+type getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher struct {
+	voucherFields `json:"-"`
+	// Input whose processing produced the voucher
+	Input getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucherInput `json:"input"`
+}
+
+// GetInput returns getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher.Input, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher) GetInput() getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucherInput {
+	return v.Input
+}
+
+// GetIndex returns getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher.Index, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher) GetIndex() int {
+	return v.voucherFields.Index
+}
+
+// GetDestination returns getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher.Destination, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher) GetDestination() string {
+	return v.voucherFields.Destination
+}
+
+// GetPayload returns getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher.Payload, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher) GetPayload() string {
+	return v.voucherFields.Payload
+}
+
+func (v *getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.voucherFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher struct {
+	Input getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucherInput `json:"input"`
+
+	Index int `json:"index"`
+
+	Destination string `json:"destination"`
+
+	Payload string `json:"payload"`
+}
+
+func (v *getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher) __premarshalJSON() (*__premarshalgetVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher, error) {
+	var retval __premarshalgetVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucher
+
+	retval.Input = v.Input
+	retval.Index = v.voucherFields.Index
+	retval.Destination = v.voucherFields.Destination
+	retval.Payload = v.voucherFields.Payload
+	return &retval, nil
+}
+
+// getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucherInput includes the requested fields of the GraphQL type Input.
+// The GraphQL type's documentation follows.
+//
+// Request submitted to the application to advance its state
+type getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucherInput struct {
+	// Input index starting from genesis
+	Index int `json:"index"`
+	// Address responsible for submitting the input
+	MsgSender string `json:"msgSender"`
+}
+
+// GetIndex returns getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucherInput.Index, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucherInput) GetIndex() int {
+	return v.Index
+}
+
+// GetMsgSender returns getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucherInput.MsgSender, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnectionEdgesVoucherEdgeNodeVoucherInput) GetMsgSender() string {
+	return v.MsgSender
+}
+
+// getVouchersVouchersVoucherConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type getVouchersVouchersVoucherConnectionPageInfo struct {
+	pageFields `json:"-"`
+}
+
+// GetEndCursor returns getVouchersVouchersVoucherConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnectionPageInfo) GetEndCursor() *string {
+	return v.pageFields.EndCursor
+}
+
+// GetHasNextPage returns getVouchersVouchersVoucherConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getVouchersVouchersVoucherConnectionPageInfo) GetHasNextPage() bool {
+	return v.pageFields.HasNextPage
+}
+
+func (v *getVouchersVouchersVoucherConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getVouchersVouchersVoucherConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getVouchersVouchersVoucherConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.pageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetVouchersVouchersVoucherConnectionPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *getVouchersVouchersVoucherConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getVouchersVouchersVoucherConnectionPageInfo) __premarshalJSON() (*__premarshalgetVouchersVouchersVoucherConnectionPageInfo, error) {
+	var retval __premarshalgetVouchersVouchersVoucherConnectionPageInfo
+
+	retval.EndCursor = v.pageFields.EndCursor
+	retval.HasNextPage = v.pageFields.HasNextPage
+	return &retval, nil
+}
+
+// inputFields includes the GraphQL fields of Input requested by the fragment inputFields.
+// The GraphQL type's documentation follows.
+//
+// Request submitted to the application to advance its state
+type inputFields struct {
+	// Input index starting from genesis
+	Index int `json:"index"`
+	// Status of the input
+	Status CompletionStatus `json:"status"`
+	// Input payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+	// Address responsible for submitting the input
+	MsgSender string `json:"msgSender"`
+	// Timestamp associated with the input submission, as defined by the base layer's block in which it was recorded
+	Timestamp string `json:"timestamp"`
+	// Number of the base layer block in which the input was recorded
+	BlockNumber string `json:"blockNumber"`
+	// Get vouchers from this particular input with support for pagination
+	Vouchers inputFieldsVouchersVoucherConnection `json:"vouchers"`
+	// Get notices from this particular input with support for pagination
+	Notices inputFieldsNoticesNoticeConnection `json:"notices"`
+	// Get reports from this particular input with support for pagination
+	Reports inputFieldsReportsReportConnection `json:"reports"`
+}
+
+// GetIndex returns inputFields.Index, and is useful for accessing the field via an interface.
+func (v *inputFields) GetIndex() int { return v.Index }
+
+// GetStatus returns inputFields.Status, and is useful for accessing the field via an interface.
+func (v *inputFields) GetStatus() CompletionStatus { return v.Status }
+
+// GetPayload returns inputFields.Payload, and is useful for accessing the field via an interface.
+func (v *inputFields) GetPayload() string { return v.Payload }
+
+// GetMsgSender returns inputFields.MsgSender, and is useful for accessing the field via an interface.
+func (v *inputFields) GetMsgSender() string { return v.MsgSender }
+
+// GetTimestamp returns inputFields.Timestamp, and is useful for accessing the field via an interface.
+func (v *inputFields) GetTimestamp() string { return v.Timestamp }
+
+// GetBlockNumber returns inputFields.BlockNumber, and is useful for accessing the field via an interface.
+func (v *inputFields) GetBlockNumber() string { return v.BlockNumber }
+
+// GetVouchers returns inputFields.Vouchers, and is useful for accessing the field via an interface.
+func (v *inputFields) GetVouchers() inputFieldsVouchersVoucherConnection { return v.Vouchers }
+
+// GetNotices returns inputFields.Notices, and is useful for accessing the field via an interface.
+func (v *inputFields) GetNotices() inputFieldsNoticesNoticeConnection { return v.Notices }
+
+// GetReports returns inputFields.Reports, and is useful for accessing the field via an interface.
+func (v *inputFields) GetReports() inputFieldsReportsReportConnection { return v.Reports }
+
+// inputFieldsNoticesNoticeConnection includes the requested fields of the GraphQL type NoticeConnection.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type inputFieldsNoticesNoticeConnection struct {
+	noticeConnectionFields `json:"-"`
+}
+
+// GetEdges returns inputFieldsNoticesNoticeConnection.Edges, and is useful for accessing the field via an interface.
+func (v *inputFieldsNoticesNoticeConnection) GetEdges() []noticeConnectionFieldsEdgesNoticeEdge {
+	return v.noticeConnectionFields.Edges
+}
+
+// GetPageInfo returns inputFieldsNoticesNoticeConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *inputFieldsNoticesNoticeConnection) GetPageInfo() noticeConnectionFieldsPageInfo {
+	return v.noticeConnectionFields.PageInfo
+}
+
+func (v *inputFieldsNoticesNoticeConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*inputFieldsNoticesNoticeConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.inputFieldsNoticesNoticeConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.noticeConnectionFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalinputFieldsNoticesNoticeConnection struct {
+	Edges []noticeConnectionFieldsEdgesNoticeEdge `json:"edges"`
+
+	PageInfo noticeConnectionFieldsPageInfo `json:"pageInfo"`
+}
+
+func (v *inputFieldsNoticesNoticeConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *inputFieldsNoticesNoticeConnection) __premarshalJSON() (*__premarshalinputFieldsNoticesNoticeConnection, error) {
+	var retval __premarshalinputFieldsNoticesNoticeConnection
+
+	retval.Edges = v.noticeConnectionFields.Edges
+	retval.PageInfo = v.noticeConnectionFields.PageInfo
+	return &retval, nil
+}
+
+// inputFieldsReportsReportConnection includes the requested fields of the GraphQL type ReportConnection.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type inputFieldsReportsReportConnection struct {
+	reportConnectionFields `json:"-"`
+}
+
+// GetEdges returns inputFieldsReportsReportConnection.Edges, and is useful for accessing the field via an interface.
+func (v *inputFieldsReportsReportConnection) GetEdges() []reportConnectionFieldsEdgesReportEdge {
+	return v.reportConnectionFields.Edges
+}
+
+// GetPageInfo returns inputFieldsReportsReportConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *inputFieldsReportsReportConnection) GetPageInfo() reportConnectionFieldsPageInfo {
+	return v.reportConnectionFields.PageInfo
+}
+
+func (v *inputFieldsReportsReportConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*inputFieldsReportsReportConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.inputFieldsReportsReportConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.reportConnectionFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalinputFieldsReportsReportConnection struct {
+	Edges []reportConnectionFieldsEdgesReportEdge `json:"edges"`
+
+	PageInfo reportConnectionFieldsPageInfo `json:"pageInfo"`
+}
+
+func (v *inputFieldsReportsReportConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *inputFieldsReportsReportConnection) __premarshalJSON() (*__premarshalinputFieldsReportsReportConnection, error) {
+	var retval __premarshalinputFieldsReportsReportConnection
+
+	retval.Edges = v.reportConnectionFields.Edges
+	retval.PageInfo = v.reportConnectionFields.PageInfo
+	return &retval, nil
+}
+
+// inputFieldsVouchersVoucherConnection includes the requested fields of the GraphQL type VoucherConnection.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type inputFieldsVouchersVoucherConnection struct {
+	voucherConnectionFields `json:"-"`
+}
+
+// GetEdges returns inputFieldsVouchersVoucherConnection.Edges, and is useful for accessing the field via an interface.
+func (v *inputFieldsVouchersVoucherConnection) GetEdges() []voucherConnectionFieldsEdgesVoucherEdge {
+	return v.voucherConnectionFields.Edges
+}
+
+// GetPageInfo returns inputFieldsVouchersVoucherConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *inputFieldsVouchersVoucherConnection) GetPageInfo() voucherConnectionFieldsPageInfo {
+	return v.voucherConnectionFields.PageInfo
+}
+
+func (v *inputFieldsVouchersVoucherConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*inputFieldsVouchersVoucherConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.inputFieldsVouchersVoucherConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.voucherConnectionFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalinputFieldsVouchersVoucherConnection struct {
+	Edges []voucherConnectionFieldsEdgesVoucherEdge `json:"edges"`
+
+	PageInfo voucherConnectionFieldsPageInfo `json:"pageInfo"`
+}
+
+func (v *inputFieldsVouchersVoucherConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *inputFieldsVouchersVoucherConnection) __premarshalJSON() (*__premarshalinputFieldsVouchersVoucherConnection, error) {
+	var retval __premarshalinputFieldsVouchersVoucherConnection
+
+	retval.Edges = v.voucherConnectionFields.Edges
+	retval.PageInfo = v.voucherConnectionFields.PageInfo
+	return &retval, nil
+}
+
+// noticeConnectionFields includes the GraphQL fields of NoticeConnection requested by the fragment noticeConnectionFields.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type noticeConnectionFields struct {
+	// Pagination entries returned for the current page
+	Edges []noticeConnectionFieldsEdgesNoticeEdge `json:"edges"`
+	// Pagination metadata
+	PageInfo noticeConnectionFieldsPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns noticeConnectionFields.Edges, and is useful for accessing the field via an interface.
+func (v *noticeConnectionFields) GetEdges() []noticeConnectionFieldsEdgesNoticeEdge { return v.Edges }
+
+// GetPageInfo returns noticeConnectionFields.PageInfo, and is useful for accessing the field via an interface.
+func (v *noticeConnectionFields) GetPageInfo() noticeConnectionFieldsPageInfo { return v.PageInfo }
+
+// noticeConnectionFieldsEdgesNoticeEdge includes the requested fields of the GraphQL type NoticeEdge.
+// The GraphQL type's documentation follows.
+//
+// Pagination entry
+type noticeConnectionFieldsEdgesNoticeEdge struct {
+	// Node instance
+	Node noticeConnectionFieldsEdgesNoticeEdgeNodeNotice `json:"node"`
+}
+
+// GetNode returns noticeConnectionFieldsEdgesNoticeEdge.Node, and is useful for accessing the field via an interface.
+func (v *noticeConnectionFieldsEdgesNoticeEdge) GetNode() noticeConnectionFieldsEdgesNoticeEdgeNodeNotice {
+	return v.Node
+}
+
+// noticeConnectionFieldsEdgesNoticeEdgeNodeNotice includes the requested fields of the GraphQL type Notice.
+// The GraphQL type's documentation follows.
+//
+// Informational statement that can be validated in the base layer blockchain
+type noticeConnectionFieldsEdgesNoticeEdgeNodeNotice struct {
+	noticeFields `json:"-"`
+}
+
+// GetIndex returns noticeConnectionFieldsEdgesNoticeEdgeNodeNotice.Index, and is useful for accessing the field via an interface.
+func (v *noticeConnectionFieldsEdgesNoticeEdgeNodeNotice) GetIndex() int { return v.noticeFields.Index }
+
+// GetPayload returns noticeConnectionFieldsEdgesNoticeEdgeNodeNotice.Payload, and is useful for accessing the field via an interface.
+func (v *noticeConnectionFieldsEdgesNoticeEdgeNodeNotice) GetPayload() string {
+	return v.noticeFields.Payload
+}
+
+func (v *noticeConnectionFieldsEdgesNoticeEdgeNodeNotice) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*noticeConnectionFieldsEdgesNoticeEdgeNodeNotice
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.noticeConnectionFieldsEdgesNoticeEdgeNodeNotice = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.noticeFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalnoticeConnectionFieldsEdgesNoticeEdgeNodeNotice struct {
+	Index int `json:"index"`
+
+	Payload string `json:"payload"`
+}
+
+func (v *noticeConnectionFieldsEdgesNoticeEdgeNodeNotice) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *noticeConnectionFieldsEdgesNoticeEdgeNodeNotice) __premarshalJSON() (*__premarshalnoticeConnectionFieldsEdgesNoticeEdgeNodeNotice, error) {
+	var retval __premarshalnoticeConnectionFieldsEdgesNoticeEdgeNodeNotice
+
+	retval.Index = v.noticeFields.Index
+	retval.Payload = v.noticeFields.Payload
+	return &retval, nil
+}
+
+// noticeConnectionFieldsPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type noticeConnectionFieldsPageInfo struct {
+	pageFields `json:"-"`
+}
+
+// GetEndCursor returns noticeConnectionFieldsPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *noticeConnectionFieldsPageInfo) GetEndCursor() *string { return v.pageFields.EndCursor }
+
+// GetHasNextPage returns noticeConnectionFieldsPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *noticeConnectionFieldsPageInfo) GetHasNextPage() bool { return v.pageFields.HasNextPage }
+
+func (v *noticeConnectionFieldsPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*noticeConnectionFieldsPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.noticeConnectionFieldsPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.pageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalnoticeConnectionFieldsPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *noticeConnectionFieldsPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *noticeConnectionFieldsPageInfo) __premarshalJSON() (*__premarshalnoticeConnectionFieldsPageInfo, error) {
+	var retval __premarshalnoticeConnectionFieldsPageInfo
+
+	retval.EndCursor = v.pageFields.EndCursor
+	retval.HasNextPage = v.pageFields.HasNextPage
+	return &retval, nil
+}
+
+// noticeFields includes the GraphQL fields of Notice requested by the fragment noticeFields.
+// The GraphQL type's documentation follows.
+//
+// Informational statement that can be validated in the base layer blockchain
+type noticeFields struct {
+	// Notice index within the context of the input that produced it
+	Index int `json:"index"`
+	// Notice data as a payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+}
+
+// GetIndex returns noticeFields.Index, and is useful for accessing the field via an interface.
+func (v *noticeFields) GetIndex() int { return v.Index }
+
+// GetPayload returns noticeFields.Payload, and is useful for accessing the field via an interface.
+func (v *noticeFields) GetPayload() string { return v.Payload }
+
+// pageFields includes the GraphQL fields of PageInfo requested by the fragment pageFields.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type pageFields struct {
+	// Cursor pointing to the last entry of the page
+	EndCursor *string `json:"endCursor"`
+	// Indicates if there are additional entries after the end curs
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns pageFields.EndCursor, and is useful for accessing the field via an interface.
+func (v *pageFields) GetEndCursor() *string { return v.EndCursor }
+
+// GetHasNextPage returns pageFields.HasNextPage, and is useful for accessing the field via an interface.
+func (v *pageFields) GetHasNextPage() bool { return v.HasNextPage }
+
+// proofFields includes the GraphQL fields of Proof requested by the fragment proofFields.
+// The GraphQL type's documentation follows.
+//
+// Data that can be used as proof to validate notices and execute vouchers on the base layer blockchain
+type proofFields struct {
+	// Validity proof for an output
+	Validity proofFieldsValidityOutputValidityProof `json:"validity"`
+	// Data that allows the validity proof to be contextualized within submitted claims, given as a payload in Ethereum hex binary format, starting with '0x'
+	Context string `json:"context"`
+}
+
+// GetValidity returns proofFields.Validity, and is useful for accessing the field via an interface.
+func (v *proofFields) GetValidity() proofFieldsValidityOutputValidityProof { return v.Validity }
+
+// GetContext returns proofFields.Context, and is useful for accessing the field via an interface.
+func (v *proofFields) GetContext() string { return v.Context }
+
+// proofFieldsValidityOutputValidityProof includes the requested fields of the GraphQL type OutputValidityProof.
+// The GraphQL type's documentation follows.
+//
+// Validity proof for an output
+type proofFieldsValidityOutputValidityProof struct {
+	// Local input index within the context of the related epoch
+	InputIndexWithinEpoch int `json:"inputIndexWithinEpoch"`
+	// Output index within the context of the input that produced it
+	OutputIndexWithinInput int `json:"outputIndexWithinInput"`
+	// Merkle root of all output hashes of the related input, given in Ethereum hex binary format (32 bytes), starting with '0x'
+	OutputHashesRootHash string `json:"outputHashesRootHash"`
+	// Merkle root of all voucher hashes of the related epoch, given in Ethereum hex binary format (32 bytes), starting with '0x'
+	VouchersEpochRootHash string `json:"vouchersEpochRootHash"`
+	// Merkle root of all notice hashes of the related epoch, given in Ethereum hex binary format (32 bytes), starting with '0x'
+	NoticesEpochRootHash string `json:"noticesEpochRootHash"`
+	// Hash of the machine state claimed for the related epoch, given in Ethereum hex binary format (32 bytes), starting with '0x'
+	MachineStateHash string `json:"machineStateHash"`
+	// Proof that this output hash is in the output-hashes merkle tree. This array of siblings is bottom-up ordered (from the leaf to the root). Each hash is given in Ethereum hex binary format (32 bytes), starting with '0x'.
+	OutputHashInOutputHashesSiblings []string `json:"outputHashInOutputHashesSiblings"`
+	// Proof that this output-hashes root hash is in epoch's output merkle tree. This array of siblings is bottom-up ordered (from the leaf to the root). Each hash is given in Ethereum hex binary format (32 bytes), starting with '0x'.
+	OutputHashesInEpochSiblings []string `json:"outputHashesInEpochSiblings"`
+}
+
+// GetInputIndexWithinEpoch returns proofFieldsValidityOutputValidityProof.InputIndexWithinEpoch, and is useful for accessing the field via an interface.
+func (v *proofFieldsValidityOutputValidityProof) GetInputIndexWithinEpoch() int {
+	return v.InputIndexWithinEpoch
+}
+
+// GetOutputIndexWithinInput returns proofFieldsValidityOutputValidityProof.OutputIndexWithinInput, and is useful for accessing the field via an interface.
+func (v *proofFieldsValidityOutputValidityProof) GetOutputIndexWithinInput() int {
+	return v.OutputIndexWithinInput
+}
+
+// GetOutputHashesRootHash returns proofFieldsValidityOutputValidityProof.OutputHashesRootHash, and is useful for accessing the field via an interface.
+func (v *proofFieldsValidityOutputValidityProof) GetOutputHashesRootHash() string {
+	return v.OutputHashesRootHash
+}
+
+// GetVouchersEpochRootHash returns proofFieldsValidityOutputValidityProof.VouchersEpochRootHash, and is useful for accessing the field via an interface.
+func (v *proofFieldsValidityOutputValidityProof) GetVouchersEpochRootHash() string {
+	return v.VouchersEpochRootHash
+}
+
+// GetNoticesEpochRootHash returns proofFieldsValidityOutputValidityProof.NoticesEpochRootHash, and is useful for accessing the field via an interface.
+func (v *proofFieldsValidityOutputValidityProof) GetNoticesEpochRootHash() string {
+	return v.NoticesEpochRootHash
+}
+
+// GetMachineStateHash returns proofFieldsValidityOutputValidityProof.MachineStateHash, and is useful for accessing the field via an interface.
+func (v *proofFieldsValidityOutputValidityProof) GetMachineStateHash() string {
+	return v.MachineStateHash
+}
+
+// GetOutputHashInOutputHashesSiblings returns proofFieldsValidityOutputValidityProof.OutputHashInOutputHashesSiblings, and is useful for accessing the field via an interface.
+func (v *proofFieldsValidityOutputValidityProof) GetOutputHashInOutputHashesSiblings() []string {
+	return v.OutputHashInOutputHashesSiblings
+}
+
+// GetOutputHashesInEpochSiblings returns proofFieldsValidityOutputValidityProof.OutputHashesInEpochSiblings, and is useful for accessing the field via an interface.
+func (v *proofFieldsValidityOutputValidityProof) GetOutputHashesInEpochSiblings() []string {
+	return v.OutputHashesInEpochSiblings
+}
+
+// reportConnectionFields includes the GraphQL fields of ReportConnection requested by the fragment reportConnectionFields.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type reportConnectionFields struct {
+	// Pagination entries returned for the current page
+	Edges []reportConnectionFieldsEdgesReportEdge `json:"edges"`
+	// Pagination metadata
+	PageInfo reportConnectionFieldsPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns reportConnectionFields.Edges, and is useful for accessing the field via an interface.
+func (v *reportConnectionFields) GetEdges() []reportConnectionFieldsEdgesReportEdge { return v.Edges }
+
+// GetPageInfo returns reportConnectionFields.PageInfo, and is useful for accessing the field via an interface.
+func (v *reportConnectionFields) GetPageInfo() reportConnectionFieldsPageInfo { return v.PageInfo }
+
+// reportConnectionFieldsEdgesReportEdge includes the requested fields of the GraphQL type ReportEdge.
+// The GraphQL type's documentation follows.
+//
+// Pagination entry
+type reportConnectionFieldsEdgesReportEdge struct {
+	// Node instance
+	Node reportConnectionFieldsEdgesReportEdgeNodeReport `json:"node"`
+}
+
+// GetNode returns reportConnectionFieldsEdgesReportEdge.Node, and is useful for accessing the field via an interface.
+func (v *reportConnectionFieldsEdgesReportEdge) GetNode() reportConnectionFieldsEdgesReportEdgeNodeReport {
+	return v.Node
+}
+
+// reportConnectionFieldsEdgesReportEdgeNodeReport includes the requested fields of the GraphQL type Report.
+// The GraphQL type's documentation follows.
+//
+// Application log or diagnostic information
+type reportConnectionFieldsEdgesReportEdgeNodeReport struct {
+	reportFields `json:"-"`
+}
+
+// GetIndex returns reportConnectionFieldsEdgesReportEdgeNodeReport.Index, and is useful for accessing the field via an interface.
+func (v *reportConnectionFieldsEdgesReportEdgeNodeReport) GetIndex() int { return v.reportFields.Index }
+
+// GetPayload returns reportConnectionFieldsEdgesReportEdgeNodeReport.Payload, and is useful for accessing the field via an interface.
+func (v *reportConnectionFieldsEdgesReportEdgeNodeReport) GetPayload() string {
+	return v.reportFields.Payload
+}
+
+func (v *reportConnectionFieldsEdgesReportEdgeNodeReport) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*reportConnectionFieldsEdgesReportEdgeNodeReport
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.reportConnectionFieldsEdgesReportEdgeNodeReport = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.reportFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalreportConnectionFieldsEdgesReportEdgeNodeReport struct {
+	Index int `json:"index"`
+
+	Payload string `json:"payload"`
+}
+
+func (v *reportConnectionFieldsEdgesReportEdgeNodeReport) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *reportConnectionFieldsEdgesReportEdgeNodeReport) __premarshalJSON() (*__premarshalreportConnectionFieldsEdgesReportEdgeNodeReport, error) {
+	var retval __premarshalreportConnectionFieldsEdgesReportEdgeNodeReport
+
+	retval.Index = v.reportFields.Index
+	retval.Payload = v.reportFields.Payload
+	return &retval, nil
+}
+
+// reportConnectionFieldsPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type reportConnectionFieldsPageInfo struct {
+	pageFields `json:"-"`
+}
+
+// GetEndCursor returns reportConnectionFieldsPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *reportConnectionFieldsPageInfo) GetEndCursor() *string { return v.pageFields.EndCursor }
+
+// GetHasNextPage returns reportConnectionFieldsPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *reportConnectionFieldsPageInfo) GetHasNextPage() bool { return v.pageFields.HasNextPage }
+
+func (v *reportConnectionFieldsPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*reportConnectionFieldsPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.reportConnectionFieldsPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.pageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalreportConnectionFieldsPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *reportConnectionFieldsPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *reportConnectionFieldsPageInfo) __premarshalJSON() (*__premarshalreportConnectionFieldsPageInfo, error) {
+	var retval __premarshalreportConnectionFieldsPageInfo
+
+	retval.EndCursor = v.pageFields.EndCursor
+	retval.HasNextPage = v.pageFields.HasNextPage
+	return &retval, nil
+}
+
+// reportFields includes the GraphQL fields of Report requested by the fragment reportFields.
+// The GraphQL type's documentation follows.
+//
+// Application log or diagnostic information
+type reportFields struct {
+	// Report index within the context of the input that produced it
+	Index int `json:"index"`
+	// Report data as a payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+}
+
+// GetIndex returns reportFields.Index, and is useful for accessing the field via an interface.
+func (v *reportFields) GetIndex() int { return v.Index }
+
+// GetPayload returns reportFields.Payload, and is useful for accessing the field via an interface.
+func (v *reportFields) GetPayload() string { return v.Payload }
+
+// voucherConnectionFields includes the GraphQL fields of VoucherConnection requested by the fragment voucherConnectionFields.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type voucherConnectionFields struct {
+	// Pagination entries returned for the current page
+	Edges []voucherConnectionFieldsEdgesVoucherEdge `json:"edges"`
+	// Pagination metadata
+	PageInfo voucherConnectionFieldsPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns voucherConnectionFields.Edges, and is useful for accessing the field via an interface.
+func (v *voucherConnectionFields) GetEdges() []voucherConnectionFieldsEdgesVoucherEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns voucherConnectionFields.PageInfo, and is useful for accessing the field via an interface.
+func (v *voucherConnectionFields) GetPageInfo() voucherConnectionFieldsPageInfo { return v.PageInfo }
+
+// voucherConnectionFieldsEdgesVoucherEdge includes the requested fields of the GraphQL type VoucherEdge.
+// The GraphQL type's documentation follows.
+//
+// Pagination entry
+type voucherConnectionFieldsEdgesVoucherEdge struct {
+	// Node instance
+	Node voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher `json:"node"`
+}
+
+// GetNode returns voucherConnectionFieldsEdgesVoucherEdge.Node, and is useful for accessing the field via an interface.
+func (v *voucherConnectionFieldsEdgesVoucherEdge) GetNode() voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher {
+	return v.Node
+}
+
+// voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher includes the requested fields of the GraphQL type Voucher.
+// The GraphQL type's documentation follows.
+//
+// Representation of a transaction that can be carried out on the base layer blockchain, such as a transfer of assets
+type voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher struct {
+	voucherFields `json:"-"`
+}
+
+// GetIndex returns voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher.Index, and is useful for accessing the field via an interface.
+func (v *voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher) GetIndex() int {
+	return v.voucherFields.Index
+}
+
+// GetDestination returns voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher.Destination, and is useful for accessing the field via an interface.
+func (v *voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher) GetDestination() string {
+	return v.voucherFields.Destination
+}
+
+// GetPayload returns voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher.Payload, and is useful for accessing the field via an interface.
+func (v *voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher) GetPayload() string {
+	return v.voucherFields.Payload
+}
+
+func (v *voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.voucherFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalvoucherConnectionFieldsEdgesVoucherEdgeNodeVoucher struct {
+	Index int `json:"index"`
+
+	Destination string `json:"destination"`
+
+	Payload string `json:"payload"`
+}
+
+func (v *voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *voucherConnectionFieldsEdgesVoucherEdgeNodeVoucher) __premarshalJSON() (*__premarshalvoucherConnectionFieldsEdgesVoucherEdgeNodeVoucher, error) {
+	var retval __premarshalvoucherConnectionFieldsEdgesVoucherEdgeNodeVoucher
+
+	retval.Index = v.voucherFields.Index
+	retval.Destination = v.voucherFields.Destination
+	retval.Payload = v.voucherFields.Payload
+	return &retval, nil
+}
+
+// voucherConnectionFieldsPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type voucherConnectionFieldsPageInfo struct {
+	pageFields `json:"-"`
+}
+
+// GetEndCursor returns voucherConnectionFieldsPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *voucherConnectionFieldsPageInfo) GetEndCursor() *string { return v.pageFields.EndCursor }
+
+// GetHasNextPage returns voucherConnectionFieldsPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *voucherConnectionFieldsPageInfo) GetHasNextPage() bool { return v.pageFields.HasNextPage }
+
+func (v *voucherConnectionFieldsPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*voucherConnectionFieldsPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.voucherConnectionFieldsPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.pageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalvoucherConnectionFieldsPageInfo struct {
+	EndCursor *string `json:"endCursor"`
+
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+func (v *voucherConnectionFieldsPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *voucherConnectionFieldsPageInfo) __premarshalJSON() (*__premarshalvoucherConnectionFieldsPageInfo, error) {
+	var retval __premarshalvoucherConnectionFieldsPageInfo
+
+	retval.EndCursor = v.pageFields.EndCursor
+	retval.HasNextPage = v.pageFields.HasNextPage
+	return &retval, nil
+}
+
+// voucherFields includes the GraphQL fields of Voucher requested by the fragment voucherFields.
+// The GraphQL type's documentation follows.
+//
+// Representation of a transaction that can be carried out on the base layer blockchain, such as a transfer of assets
+type voucherFields struct {
+	// Voucher index within the context of the input that produced it
+	Index int `json:"index"`
+	// Transaction destination address in Ethereum hex binary format (20 bytes), starting with '0x'
+	Destination string `json:"destination"`
+	// Transaction payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+}
+
+// GetIndex returns voucherFields.Index, and is useful for accessing the field via an interface.
+func (v *voucherFields) GetIndex() int { return v.Index }
+
+// GetDestination returns voucherFields.Destination, and is useful for accessing the field via an interface.
+func (v *voucherFields) GetDestination() string { return v.Destination }
+
+// GetPayload returns voucherFields.Payload, and is useful for accessing the field via an interface.
+func (v *voucherFields) GetPayload() string { return v.Payload }
+
+// The query or mutation executed by getInput.
+const getInput_Operation = `
+query getInput ($inputIndex: Int!) {
+	input(index: $inputIndex) {
+		... inputFields
+	}
+}
+fragment inputFields on Input {
+	index
+	status
+	payload
+	msgSender
+	timestamp
+	blockNumber
+	vouchers {
+		... voucherConnectionFields
+	}
+	notices {
+		... noticeConnectionFields
+	}
+	reports {
+		... reportConnectionFields
+	}
+}
+fragment voucherConnectionFields on VoucherConnection {
+	edges {
+		node {
+			... voucherFields
+		}
+	}
+	pageInfo {
+		... pageFields
+	}
+}
+fragment noticeConnectionFields on NoticeConnection {
+	edges {
+		node {
+			... noticeFields
+		}
+	}
+	pageInfo {
+		... pageFields
+	}
+}
+fragment reportConnectionFields on ReportConnection {
+	edges {
+		node {
+			... reportFields
+		}
+	}
+	pageInfo {
+		... pageFields
+	}
+}
+fragment voucherFields on Voucher {
+	index
+	destination
+	payload
+}
+fragment pageFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+fragment noticeFields on Notice {
+	index
+	payload
+}
+fragment reportFields on Report {
+	index
+	payload
+}
+`
+
+func getInput(
+	ctx context.Context,
+	client graphql.Client,
+	inputIndex int,
+) (*getInputResponse, error) {
+	req := &graphql.Request{
+		OpName: "getInput",
+		Query:  getInput_Operation,
+		Variables: &__getInputInput{
+			InputIndex: inputIndex,
+		},
+	}
+	var err error
+
+	var data getInputResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getInputNotices.
+const getInputNotices_Operation = `
+query getInputNotices ($inputIndex: Int!, $first: Int!, $after: String) {
+	input(index: $inputIndex) {
+		notices(first: $first, after: $after) {
+			... noticeConnectionFields
+		}
+	}
+}
+fragment noticeConnectionFields on NoticeConnection {
+	edges {
+		node {
+			... noticeFields
+		}
+	}
+	pageInfo {
+		... pageFields
+	}
+}
+fragment noticeFields on Notice {
+	index
+	payload
+}
+fragment pageFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+`
+
+func getInputNotices(
+	ctx context.Context,
+	client graphql.Client,
+	inputIndex int,
+	first int,
+	after *string,
+) (*getInputNoticesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getInputNotices",
+		Query:  getInputNotices_Operation,
+		Variables: &__getInputNoticesInput{
+			InputIndex: inputIndex,
+			First:      first,
+			After:      after,
+		},
+	}
+	var err error
 
-	var data getInputResponse
+	var data getInputNoticesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getInputReports.
+const getInputReports_Operation = `
+query getInputReports ($inputIndex: Int!, $first: Int!, $after: String) {
+	input(index: $inputIndex) {
+		reports(first: $first, after: $after) {
+			... reportConnectionFields
+		}
+	}
+}
+fragment reportConnectionFields on ReportConnection {
+	edges {
+		node {
+			... reportFields
+		}
+	}
+	pageInfo {
+		... pageFields
+	}
+}
+fragment reportFields on Report {
+	index
+	payload
+}
+fragment pageFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+`
+
+func getInputReports(
+	ctx context.Context,
+	client graphql.Client,
+	inputIndex int,
+	first int,
+	after *string,
+) (*getInputReportsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getInputReports",
+		Query:  getInputReports_Operation,
+		Variables: &__getInputReportsInput{
+			InputIndex: inputIndex,
+			First:      first,
+			After:      after,
+		},
+	}
+	var err error
+
+	var data getInputReportsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getInputVouchers.
+const getInputVouchers_Operation = `
+query getInputVouchers ($inputIndex: Int!, $first: Int!, $after: String) {
+	input(index: $inputIndex) {
+		vouchers(first: $first, after: $after) {
+			... voucherConnectionFields
+		}
+	}
+}
+fragment voucherConnectionFields on VoucherConnection {
+	edges {
+		node {
+			... voucherFields
+		}
+	}
+	pageInfo {
+		... pageFields
+	}
+}
+fragment voucherFields on Voucher {
+	index
+	destination
+	payload
+}
+fragment pageFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+`
+
+func getInputVouchers(
+	ctx context.Context,
+	client graphql.Client,
+	inputIndex int,
+	first int,
+	after *string,
+) (*getInputVouchersResponse, error) {
+	req := &graphql.Request{
+		OpName: "getInputVouchers",
+		Query:  getInputVouchers_Operation,
+		Variables: &__getInputVouchersInput{
+			InputIndex: inputIndex,
+			First:      first,
+			After:      after,
+		},
+	}
+	var err error
+
+	var data getInputVouchersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getInputs.
+const getInputs_Operation = `
+query getInputs ($first: Int!, $after: String, $where: InputFilter!) {
+	inputs(first: $first, after: $after, where: $where) {
+		edges {
+			node {
+				... inputFields
+			}
+		}
+		pageInfo {
+			endCursor
+			hasNextPage
+		}
+	}
+}
+fragment inputFields on Input {
+	index
+	status
+	payload
+	msgSender
+	timestamp
+	blockNumber
+	vouchers {
+		... voucherConnectionFields
+	}
+	notices {
+		... noticeConnectionFields
+	}
+	reports {
+		... reportConnectionFields
+	}
+}
+fragment voucherConnectionFields on VoucherConnection {
+	edges {
+		node {
+			... voucherFields
+		}
+	}
+	pageInfo {
+		... pageFields
+	}
+}
+fragment noticeConnectionFields on NoticeConnection {
+	edges {
+		node {
+			... noticeFields
+		}
+	}
+	pageInfo {
+		... pageFields
+	}
+}
+fragment reportConnectionFields on ReportConnection {
+	edges {
+		node {
+			... reportFields
+		}
+	}
+	pageInfo {
+		... pageFields
+	}
+}
+fragment voucherFields on Voucher {
+	index
+	destination
+	payload
+}
+fragment pageFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+fragment noticeFields on Notice {
+	index
+	payload
+}
+fragment reportFields on Report {
+	index
+	payload
+}
+`
+
+func getInputs(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+	where InputFilter,
+) (*getInputsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getInputs",
+		Query:  getInputs_Operation,
+		Variables: &__getInputsInput{
+			First: first,
+			After: after,
+			Where: where,
+		},
+	}
+	var err error
+
+	var data getInputsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getNotice.
+const getNotice_Operation = `
+query getNotice ($noticeIndex: Int!, $inputIndex: Int!) {
+	notice(noticeIndex: $noticeIndex, inputIndex: $inputIndex) {
+		index
+		payload
+		proof {
+			... proofFields
+		}
+	}
+}
+fragment proofFields on Proof {
+	validity {
+		inputIndexWithinEpoch
+		outputIndexWithinInput
+		outputHashesRootHash
+		vouchersEpochRootHash
+		noticesEpochRootHash
+		machineStateHash
+		outputHashInOutputHashesSiblings
+		outputHashesInEpochSiblings
+	}
+	context
+}
+`
+
+func getNotice(
+	ctx context.Context,
+	client graphql.Client,
+	noticeIndex int,
+	inputIndex int,
+) (*getNoticeResponse, error) {
+	req := &graphql.Request{
+		OpName: "getNotice",
+		Query:  getNotice_Operation,
+		Variables: &__getNoticeInput{
+			NoticeIndex: noticeIndex,
+			InputIndex:  inputIndex,
+		},
+	}
+	var err error
+
+	var data getNoticeResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getNotices.
+const getNotices_Operation = `
+query getNotices ($first: Int!, $after: String) {
+	notices(first: $first, after: $after) {
+		edges {
+			node {
+				... noticeFields
+				input {
+					index
+					msgSender
+				}
+			}
+		}
+		pageInfo {
+			... pageFields
+		}
+	}
+}
+fragment noticeFields on Notice {
+	index
+	payload
+}
+fragment pageFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+`
+
+func getNotices(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+) (*getNoticesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getNotices",
+		Query:  getNotices_Operation,
+		Variables: &__getNoticesInput{
+			First: first,
+			After: after,
+		},
+	}
+	var err error
+
+	var data getNoticesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getReports.
+const getReports_Operation = `
+query getReports ($first: Int!, $after: String) {
+	reports(first: $first, after: $after) {
+		edges {
+			node {
+				... reportFields
+				input {
+					index
+					msgSender
+				}
+			}
+		}
+		pageInfo {
+			... pageFields
+		}
+	}
+}
+fragment reportFields on Report {
+	index
+	payload
+}
+fragment pageFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+`
+
+func getReports(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+) (*getReportsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getReports",
+		Query:  getReports_Operation,
+		Variables: &__getReportsInput{
+			First: first,
+			After: after,
+		},
+	}
+	var err error
+
+	var data getReportsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getVoucher.
+const getVoucher_Operation = `
+query getVoucher ($voucherIndex: Int!, $inputIndex: Int!) {
+	voucher(voucherIndex: $voucherIndex, inputIndex: $inputIndex) {
+		index
+		destination
+		payload
+		proof {
+			... proofFields
+		}
+	}
+}
+fragment proofFields on Proof {
+	validity {
+		inputIndexWithinEpoch
+		outputIndexWithinInput
+		outputHashesRootHash
+		vouchersEpochRootHash
+		noticesEpochRootHash
+		machineStateHash
+		outputHashInOutputHashesSiblings
+		outputHashesInEpochSiblings
+	}
+	context
+}
+`
+
+func getVoucher(
+	ctx context.Context,
+	client graphql.Client,
+	voucherIndex int,
+	inputIndex int,
+) (*getVoucherResponse, error) {
+	req := &graphql.Request{
+		OpName: "getVoucher",
+		Query:  getVoucher_Operation,
+		Variables: &__getVoucherInput{
+			VoucherIndex: voucherIndex,
+			InputIndex:   inputIndex,
+		},
+	}
+	var err error
+
+	var data getVoucherResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getVouchers.
+const getVouchers_Operation = `
+query getVouchers ($first: Int!, $after: String) {
+	vouchers(first: $first, after: $after) {
+		edges {
+			node {
+				... voucherFields
+				input {
+					index
+					msgSender
+				}
+			}
+		}
+		pageInfo {
+			... pageFields
+		}
+	}
+}
+fragment voucherFields on Voucher {
+	index
+	destination
+	payload
+}
+fragment pageFields on PageInfo {
+	endCursor
+	hasNextPage
+}
+`
+
+func getVouchers(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	after *string,
+) (*getVouchersResponse, error) {
+	req := &graphql.Request{
+		OpName: "getVouchers",
+		Query:  getVouchers_Operation,
+		Variables: &__getVouchersInput{
+			First: first,
+			After: after,
+		},
+	}
+	var err error
+
+	var data getVouchersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -56,108 +56,337 @@ func (r *GraphQLReader) AdvanceResult(ctx context.Context, index int) (
 	_ = `# @genqlient
 	query getInput($inputIndex: Int!) {
 	  input(index: $inputIndex) {
-	    status
-	    payload
-	    msgSender
-	    timestamp
-	    blockNumber
-	    vouchers {
-	      edges {
-		node {
-		  index
-		  destination
-		  payload
-		}
-	      }
+	    ...inputFields
+	  }
+	}
+
+	fragment inputFields on Input {
+	  index
+	  status
+	  payload
+	  msgSender
+	  timestamp
+	  blockNumber
+	  vouchers {
+	    ...voucherConnectionFields
+	  }
+	  notices {
+	    ...noticeConnectionFields
+	  }
+	  reports {
+	    ...reportConnectionFields
+	  }
+	}
+
+	fragment voucherConnectionFields on VoucherConnection {
+	  edges {
+	    node {
+	      ...voucherFields
 	    }
-	    notices {
-	      edges {
-		node {
-		  index
-		  payload
-		}
-	      }
+	  }
+	  pageInfo {
+	    ...pageFields
+	  }
+	}
+
+	fragment noticeConnectionFields on NoticeConnection {
+	  edges {
+	    node {
+	      ...noticeFields
 	    }
-	    reports {
-	      edges {
-		node {
-		  index
-		  payload
-		}
-	      }
+	  }
+	  pageInfo {
+	    ...pageFields
+	  }
+	}
+
+	fragment reportConnectionFields on ReportConnection {
+	  edges {
+	    node {
+	      ...reportFields
 	    }
 	  }
+	  pageInfo {
+	    ...pageFields
+	  }
+	}
+
+	fragment voucherFields on Voucher {
+	  index
+	  destination
+	  payload
+	}
+
+	fragment noticeFields on Notice {
+	  index
+	  payload
+	}
+
+	fragment reportFields on Report {
+	  index
+	  payload
+	}
+
+	fragment pageFields on PageInfo {
+	  # @genqlient(pointer: true)
+	  endCursor
+	  hasNextPage
 	}`
 
 	resp, err := getInput(ctx, r.client, index)
 	if err != nil {
 		return nil, checkNotFound("input", err)
 	}
-
-	status, err := convertAdvanceStatus(resp.Input.Status)
+	result, err := convertInput(&resp.Input.inputFields)
 	if err != nil {
 		return nil, err
 	}
+	if err := r.fetchOutputs(ctx, result, &resp.Input.inputFields); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-	var reports []eggtypes.Report
-	for _, edge := range resp.Input.Reports.Edges {
-		var report eggtypes.Report
-		report.InputIndex = index
-		report.OutputIndex = edge.Node.Index
-		report.Payload, err = hexutil.Decode(edge.Node.Payload)
+// Number of outputs fetched in each request when paging through the outputs of an input.
+const outputsPageSize = 100
+
+// Fetch the remaining pages of the outputs of the input.
+// The input queries only return the first page of each output connection.
+func (r *GraphQLReader) fetchOutputs(ctx context.Context, result *eggtypes.AdvanceResult,
+	input *inputFields) error {
+
+	_ = `# @genqlient
+	query getInputVouchers(
+	  $inputIndex: Int!,
+	  $first: Int!,
+	  # @genqlient(pointer: true)
+	  $after: String,
+	) {
+	  input(index: $inputIndex) {
+	    vouchers(first: $first, after: $after) {
+	      ...voucherConnectionFields
+	    }
+	  }
+	}
+
+	# @genqlient
+	query getInputNotices(
+	  $inputIndex: Int!,
+	  $first: Int!,
+	  # @genqlient(pointer: true)
+	  $after: String,
+	) {
+	  input(index: $inputIndex) {
+	    notices(first: $first, after: $after) {
+	      ...noticeConnectionFields
+	    }
+	  }
+	}
+
+	# @genqlient
+	query getInputReports(
+	  $inputIndex: Int!,
+	  $first: Int!,
+	  # @genqlient(pointer: true)
+	  $after: String,
+	) {
+	  input(index: $inputIndex) {
+	    reports(first: $first, after: $after) {
+	      ...reportConnectionFields
+	    }
+	  }
+	}`
+
+	index := result.Index
+	pageInfo := &input.Vouchers.PageInfo.pageFields
+	for pageInfo.HasNextPage {
+		if pageInfo.EndCursor == nil {
+			return fmt.Errorf("missing vouchers end cursor")
+		}
+		resp, err := getInputVouchers(ctx, r.client, index, outputsPageSize, pageInfo.EndCursor)
 		if err != nil {
-			return nil, fmt.Errorf("failed to decode report payload: %v", err)
+			return fmt.Errorf("failed to get vouchers: %v", err)
 		}
-		reports = append(reports, report)
+		connection := &resp.Input.Vouchers.voucherConnectionFields
+		vouchers, err := convertVoucherConnection(index, connection)
+		if err != nil {
+			return err
+		}
+		result.Vouchers = append(result.Vouchers, vouchers...)
+		pageInfo = &connection.PageInfo.pageFields
+	}
+
+	pageInfo = &input.Notices.PageInfo.pageFields
+	for pageInfo.HasNextPage {
+		if pageInfo.EndCursor == nil {
+			return fmt.Errorf("missing notices end cursor")
+		}
+		resp, err := getInputNotices(ctx, r.client, index, outputsPageSize, pageInfo.EndCursor)
+		if err != nil {
+			return fmt.Errorf("failed to get notices: %v", err)
+		}
+		connection := &resp.Input.Notices.noticeConnectionFields
+		notices, err := convertNoticeConnection(index, connection)
+		if err != nil {
+			return err
+		}
+		result.Notices = append(result.Notices, notices...)
+		pageInfo = &connection.PageInfo.pageFields
 	}
 
-	payload, err := hexutil.Decode(resp.Input.Payload)
+	pageInfo = &input.Reports.PageInfo.pageFields
+	for pageInfo.HasNextPage {
+		if pageInfo.EndCursor == nil {
+			return fmt.Errorf("missing reports end cursor")
+		}
+		resp, err := getInputReports(ctx, r.client, index, outputsPageSize, pageInfo.EndCursor)
+		if err != nil {
+			return fmt.Errorf("failed to get reports: %v", err)
+		}
+		connection := &resp.Input.Reports.reportConnectionFields
+		reports, err := convertReportConnection(index, connection)
+		if err != nil {
+			return err
+		}
+		result.Reports = append(result.Reports, reports...)
+		pageInfo = &connection.PageInfo.pageFields
+	}
+	return nil
+}
+
+// Filter for the inputs and outputs queries.
+type InputsFilter struct {
+
+	// If set, only return inputs sent by this address.
+	// The rollups node can't filter by sender, so the reader filters the results
+	// after fetching them.
+	Sender *common.Address
+
+	// Only return inputs with index greater or equal to this value.
+	FromInput int
+
+	// If non-negative, only return inputs with index lower or equal to this value.
+	ToInput int
+}
+
+// Page of a paginated query.
+type Page[T any] struct {
+
+	// Values in the page that match the filter.
+	Results []T
+
+	// Cursor to get the next page.
+	EndCursor *string
+
+	// Whether there are more pages.
+	HasNextPage bool
+}
+
+// Get a page of inputs from the rollups node, starting after the given cursor.
+// The page may have less results than requested if some of them don't match the filter.
+// The reader fetches all outputs of each input in the page.
+func (r *GraphQLReader) Inputs(ctx context.Context, first int, after *string,
+	filter InputsFilter) (*Page[*eggtypes.AdvanceResult], error) {
+
+	_ = `# @genqlient
+	# @genqlient(for: "InputFilter.indexLowerThan", pointer: true, omitempty: true)
+	# @genqlient(for: "InputFilter.indexGreaterThan", pointer: true, omitempty: true)
+	query getInputs(
+	  $first: Int!,
+	  # @genqlient(pointer: true)
+	  $after: String,
+	  $where: InputFilter!,
+	) {
+	  inputs(first: $first, after: $after, where: $where) {
+	    edges {
+	      node {
+		...inputFields
+	      }
+	    }
+	    pageInfo {
+	      # @genqlient(pointer: true)
+	      endCursor
+	      hasNextPage
+	    }
+	  }
+	}`
+
+	var where InputFilter
+	if filter.FromInput > 0 {
+		indexGreaterThan := filter.FromInput - 1
+		where.IndexGreaterThan = &indexGreaterThan
+	}
+	if filter.ToInput >= 0 {
+		indexLowerThan := filter.ToInput + 1
+		where.IndexLowerThan = &indexLowerThan
+	}
+
+	resp, err := getInputs(ctx, r.client, first, after, where)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inputs: %v", err)
+	}
+
+	var page Page[*eggtypes.AdvanceResult]
+	for _, edge := range resp.Inputs.Edges {
+		result, err := convertInput(&edge.Node.inputFields)
+		if err != nil {
+			return nil, err
+		}
+		if filter.Sender != nil && result.Sender != *filter.Sender {
+			continue
+		}
+		if err := r.fetchOutputs(ctx, result, &edge.Node.inputFields); err != nil {
+			return nil, err
+		}
+		page.Results = append(page.Results, result)
+	}
+	page.EndCursor = resp.Inputs.PageInfo.EndCursor
+	page.HasNextPage = resp.Inputs.PageInfo.HasNextPage
+	return &page, nil
+}
+
+// Convert the GraphQL input to the advance result.
+func convertInput(input *inputFields) (*eggtypes.AdvanceResult, error) {
+	index := input.Index
+
+	status, err := convertAdvanceStatus(input.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := convertReportConnection(index, &input.Reports.reportConnectionFields)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := hexutil.Decode(input.Payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode payload: %v", err)
 	}
 
-	sender, err := hexutil.Decode(resp.Input.MsgSender)
+	sender, err := hexutil.Decode(input.MsgSender)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode msgSender: %v", err)
 	}
 
-	blockNumber, err := strconv.ParseInt(resp.Input.BlockNumber, 10, 64)
+	blockNumber, err := strconv.ParseInt(input.BlockNumber, 10, 64)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode block number: %v", err)
 	}
 
-	blockTimestamp, err := strconv.ParseInt(resp.Input.BlockNumber, 10, 64)
+	blockTimestamp, err := strconv.ParseInt(input.Timestamp, 10, 64)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode timestmap: %v", err)
 	}
 
-	var vouchers []eggtypes.Voucher
-	for _, edge := range resp.Input.Vouchers.Edges {
-		var voucher eggtypes.Voucher
-		voucher.InputIndex = index
-		voucher.OutputIndex = edge.Node.Index
-		destination, err := hexutil.Decode(edge.Node.Destination)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decode voucher destination: %v", err)
-		}
-		voucher.Destination = common.Address(destination)
-		voucher.Payload, err = hexutil.Decode(edge.Node.Payload)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decode voucher payload: %v", err)
-		}
-		vouchers = append(vouchers, voucher)
+	vouchers, err := convertVoucherConnection(index, &input.Vouchers.voucherConnectionFields)
+	if err != nil {
+		return nil, err
 	}
 
-	var notices []eggtypes.Notice
-	for _, edge := range resp.Input.Notices.Edges {
-		var notice eggtypes.Notice
-		notice.InputIndex = index
-		notice.OutputIndex = edge.Node.Index
-		notice.Payload, err = hexutil.Decode(edge.Node.Payload)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decode notice payload: %v", err)
-		}
-		notices = append(notices, notice)
+	notices, err := convertNoticeConnection(index, &input.Notices.noticeConnectionFields)
+	if err != nil {
+		return nil, err
 	}
 
 	result := &eggtypes.AdvanceResult{
@@ -177,6 +406,268 @@ func (r *GraphQLReader) AdvanceResult(ctx context.Context, index int) (
 	return result, nil
 }
 
+// Convert the page of vouchers of an input.
+func convertVoucherConnection(inputIndex int, connection *voucherConnectionFields) (
+	[]eggtypes.Voucher, error) {
+
+	var vouchers []eggtypes.Voucher
+	for _, edge := range connection.Edges {
+		voucher, err := convertVoucher(inputIndex, &edge.Node.voucherFields)
+		if err != nil {
+			return nil, err
+		}
+		vouchers = append(vouchers, voucher)
+	}
+	return vouchers, nil
+}
+
+// Convert the page of notices of an input.
+func convertNoticeConnection(inputIndex int, connection *noticeConnectionFields) (
+	[]eggtypes.Notice, error) {
+
+	var notices []eggtypes.Notice
+	for _, edge := range connection.Edges {
+		notice, err := convertNotice(inputIndex, &edge.Node.noticeFields)
+		if err != nil {
+			return nil, err
+		}
+		notices = append(notices, notice)
+	}
+	return notices, nil
+}
+
+// Convert the page of reports of an input.
+func convertReportConnection(inputIndex int, connection *reportConnectionFields) (
+	[]eggtypes.Report, error) {
+
+	var reports []eggtypes.Report
+	for _, edge := range connection.Edges {
+		report, err := convertReport(inputIndex, &edge.Node.reportFields)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// Convert the GraphQL voucher without the proof.
+func convertVoucher(inputIndex int, fields *voucherFields) (eggtypes.Voucher, error) {
+	var voucher eggtypes.Voucher
+	voucher.InputIndex = inputIndex
+	voucher.OutputIndex = fields.Index
+	destination, err := hexutil.Decode(fields.Destination)
+	if err != nil {
+		return voucher, fmt.Errorf("failed to decode voucher destination: %v", err)
+	}
+	voucher.Destination = common.Address(destination)
+	voucher.Payload, err = hexutil.Decode(fields.Payload)
+	if err != nil {
+		return voucher, fmt.Errorf("failed to decode voucher payload: %v", err)
+	}
+	return voucher, nil
+}
+
+// Convert the GraphQL notice without the proof.
+func convertNotice(inputIndex int, fields *noticeFields) (eggtypes.Notice, error) {
+	var notice eggtypes.Notice
+	var err error
+	notice.InputIndex = inputIndex
+	notice.OutputIndex = fields.Index
+	notice.Payload, err = hexutil.Decode(fields.Payload)
+	if err != nil {
+		return notice, fmt.Errorf("failed to decode notice payload: %v", err)
+	}
+	return notice, nil
+}
+
+// Convert the GraphQL report.
+func convertReport(inputIndex int, fields *reportFields) (eggtypes.Report, error) {
+	var report eggtypes.Report
+	var err error
+	report.InputIndex = inputIndex
+	report.OutputIndex = fields.Index
+	report.Payload, err = hexutil.Decode(fields.Payload)
+	if err != nil {
+		return report, fmt.Errorf("failed to decode report payload: %v", err)
+	}
+	return report, nil
+}
+
+// Check whether the output input matches the filter.
+func matchOutput(filter InputsFilter, inputIndex int, msgSender string) (bool, error) {
+	if inputIndex < filter.FromInput || (filter.ToInput >= 0 && inputIndex > filter.ToInput) {
+		return false, nil
+	}
+	if filter.Sender != nil {
+		sender, err := hexutil.Decode(msgSender)
+		if err != nil {
+			return false, fmt.Errorf("failed to decode msgSender: %v", err)
+		}
+		return common.Address(sender) == *filter.Sender, nil
+	}
+	return true, nil
+}
+
+// Get a page of vouchers from the rollups node, starting after the given cursor.
+// The rollups node doesn't filter the outputs, so the reader filters the results after
+// fetching them; the page may have less results than requested.
+func (r *GraphQLReader) Vouchers(ctx context.Context, first int, after *string,
+	filter InputsFilter) (*Page[eggtypes.Voucher], error) {
+
+	_ = `# @genqlient
+	query getVouchers(
+	  $first: Int!,
+	  # @genqlient(pointer: true)
+	  $after: String,
+	) {
+	  vouchers(first: $first, after: $after) {
+	    edges {
+	      node {
+		...voucherFields
+		input {
+		  index
+		  msgSender
+		}
+	      }
+	    }
+	    pageInfo {
+	      ...pageFields
+	    }
+	  }
+	}`
+
+	resp, err := getVouchers(ctx, r.client, first, after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vouchers: %v", err)
+	}
+	var page Page[eggtypes.Voucher]
+	for _, edge := range resp.Vouchers.Edges {
+		input := edge.Node.Input
+		ok, err := matchOutput(filter, input.Index, input.MsgSender)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		voucher, err := convertVoucher(input.Index, &edge.Node.voucherFields)
+		if err != nil {
+			return nil, err
+		}
+		page.Results = append(page.Results, voucher)
+	}
+	page.EndCursor = resp.Vouchers.PageInfo.EndCursor
+	page.HasNextPage = resp.Vouchers.PageInfo.HasNextPage
+	return &page, nil
+}
+
+// Get a page of notices from the rollups node, starting after the given cursor.
+// The rollups node doesn't filter the outputs, so the reader filters the results after
+// fetching them; the page may have less results than requested.
+func (r *GraphQLReader) Notices(ctx context.Context, first int, after *string,
+	filter InputsFilter) (*Page[eggtypes.Notice], error) {
+
+	_ = `# @genqlient
+	query getNotices(
+	  $first: Int!,
+	  # @genqlient(pointer: true)
+	  $after: String,
+	) {
+	  notices(first: $first, after: $after) {
+	    edges {
+	      node {
+		...noticeFields
+		input {
+		  index
+		  msgSender
+		}
+	      }
+	    }
+	    pageInfo {
+	      ...pageFields
+	    }
+	  }
+	}`
+
+	resp, err := getNotices(ctx, r.client, first, after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notices: %v", err)
+	}
+	var page Page[eggtypes.Notice]
+	for _, edge := range resp.Notices.Edges {
+		input := edge.Node.Input
+		ok, err := matchOutput(filter, input.Index, input.MsgSender)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		notice, err := convertNotice(input.Index, &edge.Node.noticeFields)
+		if err != nil {
+			return nil, err
+		}
+		page.Results = append(page.Results, notice)
+	}
+	page.EndCursor = resp.Notices.PageInfo.EndCursor
+	page.HasNextPage = resp.Notices.PageInfo.HasNextPage
+	return &page, nil
+}
+
+// Get a page of reports from the rollups node, starting after the given cursor.
+// The rollups node doesn't filter the outputs, so the reader filters the results after
+// fetching them; the page may have less results than requested.
+func (r *GraphQLReader) Reports(ctx context.Context, first int, after *string,
+	filter InputsFilter) (*Page[eggtypes.Report], error) {
+
+	_ = `# @genqlient
+	query getReports(
+	  $first: Int!,
+	  # @genqlient(pointer: true)
+	  $after: String,
+	) {
+	  reports(first: $first, after: $after) {
+	    edges {
+	      node {
+		...reportFields
+		input {
+		  index
+		  msgSender
+		}
+	      }
+	    }
+	    pageInfo {
+	      ...pageFields
+	    }
+	  }
+	}`
+
+	resp, err := getReports(ctx, r.client, first, after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reports: %v", err)
+	}
+	var page Page[eggtypes.Report]
+	for _, edge := range resp.Reports.Edges {
+		input := edge.Node.Input
+		ok, err := matchOutput(filter, input.Index, input.MsgSender)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		report, err := convertReport(input.Index, &edge.Node.reportFields)
+		if err != nil {
+			return nil, err
+		}
+		page.Results = append(page.Results, report)
+	}
+	page.EndCursor = resp.Reports.PageInfo.EndCursor
+	page.HasNextPage = resp.Reports.PageInfo.HasNextPage
+	return &page, nil
+}
+
 func convertAdvanceStatus(s CompletionStatus) (eggtypes.CompletionStatus, error) {
 	statusMap := map[CompletionStatus]eggtypes.CompletionStatus{
 		CompletionStatusUnprocessed:                eggtypes.CompletionStatusUnprocessed,
@@ -194,3 +685,156 @@ func convertAdvanceStatus(s CompletionStatus) (eggtypes.CompletionStatus, error)
 	}
 	return status, nil
 }
+
+// Get a voucher from the rollups node.
+// The voucher proof is nil if the voucher epoch is not finalized yet.
+func (r *GraphQLReader) Voucher(ctx context.Context, inputIndex int, outputIndex int) (
+	*eggtypes.Voucher, error) {
+
+	_ = `# @genqlient
+	query getVoucher($voucherIndex: Int!, $inputIndex: Int!) {
+	  voucher(voucherIndex: $voucherIndex, inputIndex: $inputIndex) {
+	    index
+	    destination
+	    payload
+	    # @genqlient(pointer: true)
+	    proof {
+	      ...proofFields
+	    }
+	  }
+	}
+
+	fragment proofFields on Proof {
+	  validity {
+	    inputIndexWithinEpoch
+	    outputIndexWithinInput
+	    outputHashesRootHash
+	    vouchersEpochRootHash
+	    noticesEpochRootHash
+	    machineStateHash
+	    outputHashInOutputHashesSiblings
+	    outputHashesInEpochSiblings
+	  }
+	  context
+	}`
+
+	resp, err := getVoucher(ctx, r.client, outputIndex, inputIndex)
+	if err != nil {
+		return nil, checkNotFound("voucher", err)
+	}
+
+	var voucher eggtypes.Voucher
+	voucher.InputIndex = inputIndex
+	voucher.OutputIndex = resp.Voucher.Index
+	destination, err := hexutil.Decode(resp.Voucher.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode voucher destination: %v", err)
+	}
+	voucher.Destination = common.Address(destination)
+	voucher.Payload, err = hexutil.Decode(resp.Voucher.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode voucher payload: %v", err)
+	}
+	if resp.Voucher.Proof != nil {
+		voucher.Proof, err = convertProof(&resp.Voucher.Proof.proofFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &voucher, nil
+}
+
+// Get a notice from the rollups node.
+// The notice proof is nil if the notice epoch is not finalized yet.
+func (r *GraphQLReader) Notice(ctx context.Context, inputIndex int, outputIndex int) (
+	*eggtypes.Notice, error) {
+
+	_ = `# @genqlient
+	query getNotice($noticeIndex: Int!, $inputIndex: Int!) {
+	  notice(noticeIndex: $noticeIndex, inputIndex: $inputIndex) {
+	    index
+	    payload
+	    # @genqlient(pointer: true)
+	    proof {
+	      ...proofFields
+	    }
+	  }
+	}`
+
+	resp, err := getNotice(ctx, r.client, outputIndex, inputIndex)
+	if err != nil {
+		return nil, checkNotFound("notice", err)
+	}
+
+	var notice eggtypes.Notice
+	notice.InputIndex = inputIndex
+	notice.OutputIndex = resp.Notice.Index
+	notice.Payload, err = hexutil.Decode(resp.Notice.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode notice payload: %v", err)
+	}
+	if resp.Notice.Proof != nil {
+		notice.Proof, err = convertProof(&resp.Notice.Proof.proofFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &notice, nil
+}
+
+// Convert the GraphQL proof to the eggtypes proof.
+func convertProof(fields *proofFields) (*eggtypes.Proof, error) {
+	var proof eggtypes.Proof
+	var err error
+	validity := fields.Validity
+	proof.InputIndexWithinEpoch = uint64(validity.InputIndexWithinEpoch)
+	proof.OutputIndexWithinInput = uint64(validity.OutputIndexWithinInput)
+	proof.OutputHashesRootHash, err = decodeHash(validity.OutputHashesRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode output hashes root hash: %v", err)
+	}
+	proof.VouchersEpochRootHash, err = decodeHash(validity.VouchersEpochRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode vouchers epoch root hash: %v", err)
+	}
+	proof.NoticesEpochRootHash, err = decodeHash(validity.NoticesEpochRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode notices epoch root hash: %v", err)
+	}
+	proof.MachineStateHash, err = decodeHash(validity.MachineStateHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode machine state hash: %v", err)
+	}
+	for _, sibling := range validity.OutputHashInOutputHashesSiblings {
+		hash, err := decodeHash(sibling)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode output hash sibling: %v", err)
+		}
+		proof.OutputHashInOutputHashesSiblings = append(
+			proof.OutputHashInOutputHashesSiblings, hash)
+	}
+	for _, sibling := range validity.OutputHashesInEpochSiblings {
+		hash, err := decodeHash(sibling)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode output hashes sibling: %v", err)
+		}
+		proof.OutputHashesInEpochSiblings = append(proof.OutputHashesInEpochSiblings, hash)
+	}
+	proof.Context, err = hexutil.Decode(fields.Context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode proof context: %v", err)
+	}
+	return &proof, nil
+}
+
+// Decode a 32-byte hash in hex format.
+func decodeHash(s string) (common.Hash, error) {
+	data, err := hexutil.Decode(s)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(data) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid hash length: %v", len(data))
+	}
+	return common.Hash(data), nil
+}
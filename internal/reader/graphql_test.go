@@ -0,0 +1,264 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package reader
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Start a fake GraphQL server that always returns the given response.
+func setupGraphQL(t *testing.T, response string) *GraphQLReader {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(response))
+		},
+	))
+	t.Cleanup(server.Close)
+	return NewGraphQLReader(server.URL)
+}
+
+func TestVoucherWithProof(t *testing.T) {
+	reader := setupGraphQL(t, `{"data": {"voucher": {
+		"index": 1,
+		"destination": "0xfafafafafafafafafafafafafafafafafafafafa",
+		"payload": "0xdeadbeef",
+		"proof": {
+			"validity": {
+				"inputIndexWithinEpoch": 2,
+				"outputIndexWithinInput": 1,
+				"outputHashesRootHash": "0x0101010101010101010101010101010101010101010101010101010101010101",
+				"vouchersEpochRootHash": "0x0202020202020202020202020202020202020202020202020202020202020202",
+				"noticesEpochRootHash": "0x0303030303030303030303030303030303030303030303030303030303030303",
+				"machineStateHash": "0x0404040404040404040404040404040404040404040404040404040404040404",
+				"outputHashInOutputHashesSiblings": [
+					"0x0505050505050505050505050505050505050505050505050505050505050505"
+				],
+				"outputHashesInEpochSiblings": []
+			},
+			"context": "0x00"
+		}
+	}}}`)
+	voucher, err := reader.Voucher(context.Background(), 3, 1)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if voucher.InputIndex != 3 || voucher.OutputIndex != 1 {
+		t.Fatalf("wrong indices: %v %v", voucher.InputIndex, voucher.OutputIndex)
+	}
+	if voucher.Destination != common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa") {
+		t.Fatalf("wrong destination: %v", voucher.Destination)
+	}
+	proof := voucher.Proof
+	if proof == nil {
+		t.Fatalf("expected proof")
+	}
+	if proof.InputIndexWithinEpoch != 2 || proof.OutputIndexWithinInput != 1 {
+		t.Fatalf("wrong proof indices: %+v", proof)
+	}
+	if proof.MachineStateHash[0] != 4 {
+		t.Fatalf("wrong machine state hash: %v", proof.MachineStateHash)
+	}
+	if len(proof.OutputHashInOutputHashesSiblings) != 1 ||
+		proof.OutputHashInOutputHashesSiblings[0][0] != 5 {
+		t.Fatalf("wrong siblings: %v", proof.OutputHashInOutputHashesSiblings)
+	}
+	if len(proof.OutputHashesInEpochSiblings) != 0 {
+		t.Fatalf("wrong siblings: %v", proof.OutputHashesInEpochSiblings)
+	}
+}
+
+func TestVoucherWithoutProof(t *testing.T) {
+	reader := setupGraphQL(t, `{"data": {"voucher": {
+		"index": 0,
+		"destination": "0xfafafafafafafafafafafafafafafafafafafafa",
+		"payload": "0xdeadbeef",
+		"proof": null
+	}}}`)
+	voucher, err := reader.Voucher(context.Background(), 0, 0)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if voucher.Proof != nil {
+		t.Fatalf("expected nil proof")
+	}
+}
+
+func TestNoticeWithoutProof(t *testing.T) {
+	reader := setupGraphQL(t, `{"data": {"notice": {
+		"index": 2,
+		"payload": "0xdeadbeef",
+		"proof": null
+	}}}`)
+	notice, err := reader.Notice(context.Background(), 1, 2)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if notice.InputIndex != 1 || notice.OutputIndex != 2 {
+		t.Fatalf("wrong indices: %v %v", notice.InputIndex, notice.OutputIndex)
+	}
+	if common.Bytes2Hex(notice.Payload) != "deadbeef" {
+		t.Fatalf("wrong payload: %x", notice.Payload)
+	}
+	if notice.Proof != nil {
+		t.Fatalf("expected nil proof")
+	}
+}
+
+func TestInputsFilter(t *testing.T) {
+	var where map[string]any
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var request struct {
+				Variables struct {
+					Where map[string]any `json:"where"`
+				} `json:"variables"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			where = request.Variables.Where
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"data": {"inputs": {"edges": [], "pageInfo": {"hasNextPage": false}}}}`))
+		},
+	))
+	defer server.Close()
+	reader := NewGraphQLReader(server.URL)
+
+	filter := InputsFilter{FromInput: 3, ToInput: 5}
+	page, err := reader.Inputs(context.Background(), 10, nil, filter)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if len(page.Results) != 0 || page.HasNextPage || page.EndCursor != nil {
+		t.Fatalf("wrong page: %+v", page)
+	}
+	expectedWhere := map[string]any{"indexGreaterThan": 2.0, "indexLowerThan": 6.0}
+	if !reflect.DeepEqual(where, expectedWhere) {
+		t.Fatalf("wrong filter: %v", where)
+	}
+
+	filter = InputsFilter{FromInput: 0, ToInput: -1}
+	_, err = reader.Inputs(context.Background(), 10, nil, filter)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if len(where) != 0 {
+		t.Fatalf("wrong filter: %v", where)
+	}
+}
+
+func TestAdvanceResultOutputsPagination(t *testing.T) {
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var request struct {
+				OperationName string `json:"operationName"`
+				Variables     struct {
+					After *string `json:"after"`
+				} `json:"variables"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			switch request.OperationName {
+			case "getInput":
+				w.Write([]byte(`{"data": {"input": {
+					"index": 3,
+					"status": "ACCEPTED",
+					"payload": "0x",
+					"msgSender": "0xfafafafafafafafafafafafafafafafafafafafa",
+					"timestamp": "1000",
+					"blockNumber": "10",
+					"vouchers": {
+						"edges": [{"node": {
+							"index": 0,
+							"destination": "0xfafafafafafafafafafafafafafafafafafafafa",
+							"payload": "0x00"
+						}}],
+						"pageInfo": {"endCursor": "0", "hasNextPage": true}
+					},
+					"notices": {"edges": [], "pageInfo": {"hasNextPage": false}},
+					"reports": {"edges": [], "pageInfo": {"hasNextPage": false}}
+				}}}`))
+			case "getInputVouchers":
+				afters = append(afters, *request.Variables.After)
+				w.Write([]byte(`{"data": {"input": {"vouchers": {
+					"edges": [{"node": {
+						"index": 1,
+						"destination": "0xfafafafafafafafafafafafafafafafafafafafa",
+						"payload": "0x01"
+					}}],
+					"pageInfo": {"endCursor": "1", "hasNextPage": false}
+				}}}}`))
+			default:
+				t.Errorf("unexpected operation: %v", request.OperationName)
+			}
+		},
+	))
+	defer server.Close()
+	reader := NewGraphQLReader(server.URL)
+
+	result, err := reader.AdvanceResult(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if !reflect.DeepEqual(afters, []string{"0"}) {
+		t.Fatalf("wrong cursors: %v", afters)
+	}
+	if len(result.Vouchers) != 2 {
+		t.Fatalf("wrong vouchers: %v", result.Vouchers)
+	}
+	for i, voucher := range result.Vouchers {
+		if voucher.InputIndex != 3 || voucher.OutputIndex != i || voucher.Payload[0] != byte(i) {
+			t.Fatalf("wrong voucher: %+v", voucher)
+		}
+	}
+}
+
+func TestVouchersFilter(t *testing.T) {
+	reader := setupGraphQL(t, `{"data": {"vouchers": {
+		"edges": [
+			{"node": {
+				"index": 0,
+				"destination": "0xfafafafafafafafafafafafafafafafafafafafa",
+				"payload": "0x00",
+				"input": {"index": 0, "msgSender": "0xfafafafafafafafafafafafafafafafafafafafa"}
+			}},
+			{"node": {
+				"index": 0,
+				"destination": "0xfafafafafafafafafafafafafafafafafafafafa",
+				"payload": "0x01",
+				"input": {"index": 1, "msgSender": "0xfefefefefefefefefefefefefefefefefefefefe"}
+			}},
+			{"node": {
+				"index": 0,
+				"destination": "0xfafafafafafafafafafafafafafafafafafafafa",
+				"payload": "0x02",
+				"input": {"index": 2, "msgSender": "0xfafafafafafafafafafafafafafafafafafafafa"}
+			}}
+		],
+		"pageInfo": {"endCursor": "2", "hasNextPage": true}
+	}}}`)
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	filter := InputsFilter{Sender: &sender, FromInput: 1, ToInput: -1}
+	page, err := reader.Vouchers(context.Background(), 3, nil, filter)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if len(page.Results) != 1 || page.Results[0].InputIndex != 2 {
+		t.Fatalf("wrong vouchers: %+v", page.Results)
+	}
+	if !page.HasNextPage || page.EndCursor == nil || *page.EndCursor != "2" {
+		t.Fatalf("wrong page info: %+v", page)
+	}
+}
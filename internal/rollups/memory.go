@@ -0,0 +1,174 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package rollups
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Error returned by Finish after the in-memory rollups is closed.
+var ErrClosed = errors.New("rollups closed")
+
+// Error returned by Process when the contract stopped processing inputs.
+var ErrStopped = errors.New("rollups stopped")
+
+// Voucher sent to the in-memory rollups.
+type MemoryVoucher struct {
+	Destination common.Address
+	Payload     []byte
+}
+
+// Outputs generated by the contract when processing an input.
+type MemoryResult struct {
+	Status   FinishStatus
+	Vouchers []MemoryVoucher
+	Notices  [][]byte
+	Reports  [][]byte
+
+	// Payload of the exception; nil if the contract didn't raise one.
+	Exception []byte
+}
+
+// Implement the Rollups API in memory.
+// The contract runs in one goroutine calling the Rollups API methods, while
+// another goroutine sends the inputs with Process.
+type RollupsMemory struct {
+	inputs    chan any
+	results   chan *MemoryResult
+	stopped   chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	// The fields below are only accessed by the contract goroutine.
+	result     *MemoryResult
+	inspecting bool
+}
+
+// Create a new in-memory Rollups API.
+func NewRollupsMemory() *RollupsMemory {
+	return &RollupsMemory{
+		inputs:  make(chan any),
+		results: make(chan *MemoryResult),
+		stopped: make(chan struct{}),
+		closed:  make(chan struct{}),
+	}
+}
+
+// Send the input to the contract and wait until it finishes processing it.
+// The input should be an AdvanceInput or an InspectInput.
+// Return ErrStopped if the contract is no longer processing inputs or if the
+// rollups is closed.
+func (r *RollupsMemory) Process(input any) (*MemoryResult, error) {
+	select {
+	case <-r.closed:
+		return nil, ErrStopped
+	default:
+	}
+	select {
+	case r.inputs <- input:
+	case <-r.stopped:
+		return nil, ErrStopped
+	case <-r.closed:
+		return nil, ErrStopped
+	}
+	select {
+	case result := <-r.results:
+		return result, nil
+	case <-r.stopped:
+		return nil, ErrStopped
+	}
+}
+
+// Close the rollups, making Finish return ErrClosed and Process return ErrStopped.
+// It is safe to call Close more than once.
+func (r *RollupsMemory) Close() {
+	r.closeOnce.Do(func() {
+		close(r.closed)
+	})
+}
+
+// Signal that the contract stopped processing inputs.
+func (r *RollupsMemory) Stop() {
+	close(r.stopped)
+}
+
+// Send a voucher to the Rollups API. Return the voucher index.
+func (r *RollupsMemory) SendVoucher(destination common.Address, payload []byte) (int, error) {
+	if err := r.checkAdvance("voucher"); err != nil {
+		return 0, err
+	}
+	voucher := MemoryVoucher{
+		Destination: destination,
+		Payload:     payload,
+	}
+	r.result.Vouchers = append(r.result.Vouchers, voucher)
+	return len(r.result.Vouchers) - 1, nil
+}
+
+// Send a notice to the Rollups API. Return the notice index.
+func (r *RollupsMemory) SendNotice(payload []byte) (int, error) {
+	if err := r.checkAdvance("notice"); err != nil {
+		return 0, err
+	}
+	r.result.Notices = append(r.result.Notices, payload)
+	return len(r.result.Notices) - 1, nil
+}
+
+// Send a report to the Rollups API.
+func (r *RollupsMemory) SendReport(payload []byte) error {
+	if r.result == nil {
+		return fmt.Errorf("no input being processed")
+	}
+	r.result.Reports = append(r.result.Reports, payload)
+	return nil
+}
+
+// Send an exception to the Rollups API.
+// Send the result of the current input with the exception.
+func (r *RollupsMemory) SendException(payload []byte) error {
+	if r.result == nil {
+		return fmt.Errorf("no input being processed")
+	}
+	if payload == nil {
+		payload = []byte{}
+	}
+	r.result.Exception = payload
+	r.results <- r.result
+	r.result = nil
+	return nil
+}
+
+// Send a finish request to the Rollups API.
+// Send the result of the current input and wait for the next one.
+func (r *RollupsMemory) Finish(status FinishStatus) (any, error) {
+	if r.result != nil {
+		r.result.Status = status
+		r.results <- r.result
+		r.result = nil
+	}
+	var input any
+	select {
+	case input = <-r.inputs:
+	case <-r.closed:
+		return nil, ErrClosed
+	}
+	r.result = &MemoryResult{}
+	_, r.inspecting = input.(*InspectInput)
+	return input, nil
+}
+
+// Check whether the current input is an advance.
+func (r *RollupsMemory) checkAdvance(output string) error {
+	if r.result == nil {
+		return fmt.Errorf("no input being processed")
+	}
+	if r.inspecting {
+		return fmt.Errorf("can't send %v during inspect", output)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package rollups
+
+import (
+	"testing"
+)
+
+func TestRollupsMemoryProcessAfterClose(t *testing.T) {
+	r := NewRollupsMemory()
+	done := make(chan error)
+	go func() {
+		defer r.Stop()
+		for {
+			if _, err := r.Finish(FinishStatusAccept); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+
+	result, err := r.Process(&AdvanceInput{})
+	if err != nil {
+		t.Fatalf("failed to process input: %v", err)
+	}
+	if result.Status != FinishStatusAccept {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+
+	r.Close()
+	if err := <-done; err != ErrClosed {
+		t.Fatalf("wrong finish error: %v", err)
+	}
+	if _, err := r.Process(&AdvanceInput{}); err != ErrStopped {
+		t.Fatalf("wrong process error: %v", err)
+	}
+}
+
+func TestRollupsMemoryProcessAfterCloseWithoutStop(t *testing.T) {
+	r := NewRollupsMemory()
+	r.Close()
+	r.Close()
+	if _, err := r.Process(&InspectInput{}); err != ErrStopped {
+		t.Fatalf("wrong process error: %v", err)
+	}
+}
@@ -51,6 +51,27 @@ func (status FinishStatus) String() string {
 	return toString[status]
 }
 
+// Interface to the Rollups API used by the DApp contract.
+type RollupsAPI interface {
+
+	// Send a voucher to the Rollups API. Return the voucher index.
+	SendVoucher(destination common.Address, payload []byte) (int, error)
+
+	// Send a notice to the Rollups API. Return the notice index.
+	SendNotice(payload []byte) (int, error)
+
+	// Send a report to the Rollups API.
+	SendReport(payload []byte) error
+
+	// Send an exception to the Rollups API.
+	// After an exception, the DApp should not process more inputs.
+	SendException(payload []byte) error
+
+	// Send a finish request to the Rollups API.
+	// If there is no error, return an AdvanceInput or an InspectInput.
+	Finish(status FinishStatus) (any, error)
+}
+
 // Implement the Rollups API using the Rollups HTTP server.
 type RollupsHTTP struct {
 	endpoint string
@@ -167,6 +188,32 @@ func (r *RollupsHTTP) SendReport(payload []byte) error {
 	return nil
 }
 
+// Send an exception to the Rollups API.
+func (r *RollupsHTTP) SendException(payload []byte) error {
+	request := struct {
+		Payload string `json:"payload"`
+	}{
+		Payload: hexutil.Encode(payload),
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to serialize request: %v", err)
+	}
+
+	resp, err := r.sendPost("exception", body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err = checkStatusOk(resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Send a finish request to the Rollups API.
 // If there is no error, return an AdvanceInput or an InspectInput.
 func (r *RollupsHTTP) Finish(status FinishStatus) (any, error) {
@@ -18,6 +18,9 @@ type RunOpts struct {
 	// Run in no backend mode
 	NoBackend bool
 
+	// Duration of the epoch in seconds; if zero, use the sunodo default.
+	EpochDuration int
+
 	// Stdout writer
 	Stdout io.Writer
 
@@ -35,6 +38,9 @@ func Run(ctx context.Context, opts RunOpts) error {
 	if opts.NoBackend {
 		args = append(args, "--no-backend")
 	}
+	if opts.EpochDuration != 0 {
+		args = append(args, "--epoch-duration", fmt.Sprint(opts.EpochDuration))
+	}
 
 	cmd := exec.CommandContext(ctx, "sunodo", args...)
 	cmd.Stderr = opts.Stderr
@@ -0,0 +1,855 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// AuthorityMetaData contains all meta data concerning the Authority contract.
+var AuthorityMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_owner\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"application\",\"type\":\"address\"}],\"name\":\"ApplicationJoined\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"contractIHistory\",\"name\":\"history\",\"type\":\"address\"}],\"name\":\"NewHistory\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_dapp\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_proofContext\",\"type\":\"bytes\"}],\"name\":\"getClaim\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getHistory\",\"outputs\":[{\"internalType\":\"contractIHistory\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"join\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_consensus\",\"type\":\"address\"}],\"name\":\"migrateHistoryToConsensus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIHistory\",\"name\":\"_history\",\"type\":\"address\"}],\"name\":\"setHistory\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_claimData\",\"type\":\"bytes\"}],\"name\":\"submitClaim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b506040516108a63803806108a683398101604081905261002f91610167565b61003833610047565b61004181610097565b50610197565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6000546001600160a01b031633146100f65760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064015b60405180910390fd5b6001600160a01b03811661015b5760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b60648201526084016100ed565b61016481610047565b50565b60006020828403121561017957600080fd5b81516001600160a01b038116811461019057600080fd5b9392505050565b610700806101a66000396000f3fe608060405234801561001057600080fd5b50600436106100935760003560e01c8063aa15efc811610066578063aa15efc8146100f2578063b688a36314610103578063d79a82401461010b578063ddfdfbb014610139578063f2fde38b1461014c57600080fd5b8063159c5ea114610098578063715018a6146100ad5780638da5cb5b146100b55780639368a3d3146100df575b600080fd5b6100ab6100a63660046104f0565b61015f565b005b6100ab6101e6565b6000546001600160a01b03165b6040516001600160a01b0390911681526020015b60405180910390f35b6100ab6100ed3660046104f0565b61021c565b6001546001600160a01b03166100c2565b6100ab6102a8565b61011e61011936600461055d565b6102d5565b604080519384526020840192909252908201526060016100d6565b6100ab6101473660046105b2565b61035e565b6100ab61015a3660046104f0565b6103f0565b6000546001600160a01b031633146101925760405162461bcd60e51b8152600401610189906105f4565b60405180910390fd5b600180546001600160a01b0319166001600160a01b0383169081179091556040519081527f2bcd43869347a1d42f97ac6042f3d129817abd05a6125f9750fe3724e321d23e9060200160405180910390a150565b6000546001600160a01b031633146102105760405162461bcd60e51b8152600401610189906105f4565b61021a600061048b565b565b6000546001600160a01b031633146102465760405162461bcd60e51b8152600401610189906105f4565b60015460405163fc41168360e01b81526001600160a01b0383811660048301529091169063fc41168390602401600060405180830381600087803b15801561028d57600080fd5b505af11580156102a1573d6000803e3d6000fd5b5050505050565b60405133907f27c2b702d3bff195a18baca2daf00b20a986177c5f1449af4e2d46a3c3e02ce590600090a2565b60015460405163035e6a0960e61b8152600091829182916001600160a01b03169063d79a82409061030e90899089908990600401610652565b606060405180830381865afa15801561032b573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061034f9190610680565b92509250925093509350939050565b6000546001600160a01b031633146103885760405162461bcd60e51b8152600401610189906105f4565b600154604051630ddfdfbb60e41b81526001600160a01b039091169063ddfdfbb0906103ba90859085906004016106ae565b600060405180830381600087803b1580156103d457600080fd5b505af11580156103e8573d6000803e3d6000fd5b505050505050565b6000546001600160a01b0316331461041a5760405162461bcd60e51b8152600401610189906105f4565b6001600160a01b03811661047f5760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610189565b6104888161048b565b50565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6001600160a01b038116811461048857600080fd5b60006020828403121561050257600080fd5b813561050d816104db565b9392505050565b60008083601f84011261052657600080fd5b50813567ffffffffffffffff81111561053e57600080fd5b60208301915083602082850101111561055657600080fd5b9250929050565b60008060006040848603121561057257600080fd5b833561057d816104db565b9250602084013567ffffffffffffffff81111561059957600080fd5b6105a586828701610514565b9497909650939450505050565b600080602083850312156105c557600080fd5b823567ffffffffffffffff8111156105dc57600080fd5b6105e885828601610514565b90969095509350505050565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b6001600160a01b03841681526040602082018190526000906106779083018486610629565b95945050505050565b60008060006060848603121561069557600080fd5b8351925060208401519150604084015190509250925092565b6020815260006106c2602083018486610629565b94935050505056fea26469706673582212203f70d6552b8e4889ca939bf543a835f7dfc5cac5de21f18fcfc866a71f30b8b064736f6c63430008150033",
+}
+
+// AuthorityABI is the input ABI used to generate the binding from.
+// Deprecated: Use AuthorityMetaData.ABI instead.
+var AuthorityABI = AuthorityMetaData.ABI
+
+// AuthorityBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use AuthorityMetaData.Bin instead.
+var AuthorityBin = AuthorityMetaData.Bin
+
+// DeployAuthority deploys a new Ethereum contract, binding an instance of Authority to it.
+func DeployAuthority(auth *bind.TransactOpts, backend bind.ContractBackend, _owner common.Address) (common.Address, *types.Transaction, *Authority, error) {
+	parsed, err := AuthorityMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(AuthorityBin), backend, _owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Authority{AuthorityCaller: AuthorityCaller{contract: contract}, AuthorityTransactor: AuthorityTransactor{contract: contract}, AuthorityFilterer: AuthorityFilterer{contract: contract}}, nil
+}
+
+// Authority is an auto generated Go binding around an Ethereum contract.
+type Authority struct {
+	AuthorityCaller     // Read-only binding to the contract
+	AuthorityTransactor // Write-only binding to the contract
+	AuthorityFilterer   // Log filterer for contract events
+}
+
+// AuthorityCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AuthorityCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuthorityTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AuthorityTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuthorityFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AuthorityFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuthoritySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AuthoritySession struct {
+	Contract     *Authority        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AuthorityCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AuthorityCallerSession struct {
+	Contract *AuthorityCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// AuthorityTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AuthorityTransactorSession struct {
+	Contract     *AuthorityTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// AuthorityRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AuthorityRaw struct {
+	Contract *Authority // Generic contract binding to access the raw methods on
+}
+
+// AuthorityCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AuthorityCallerRaw struct {
+	Contract *AuthorityCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AuthorityTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AuthorityTransactorRaw struct {
+	Contract *AuthorityTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAuthority creates a new instance of Authority, bound to a specific deployed contract.
+func NewAuthority(address common.Address, backend bind.ContractBackend) (*Authority, error) {
+	contract, err := bindAuthority(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Authority{AuthorityCaller: AuthorityCaller{contract: contract}, AuthorityTransactor: AuthorityTransactor{contract: contract}, AuthorityFilterer: AuthorityFilterer{contract: contract}}, nil
+}
+
+// NewAuthorityCaller creates a new read-only instance of Authority, bound to a specific deployed contract.
+func NewAuthorityCaller(address common.Address, caller bind.ContractCaller) (*AuthorityCaller, error) {
+	contract, err := bindAuthority(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthorityCaller{contract: contract}, nil
+}
+
+// NewAuthorityTransactor creates a new write-only instance of Authority, bound to a specific deployed contract.
+func NewAuthorityTransactor(address common.Address, transactor bind.ContractTransactor) (*AuthorityTransactor, error) {
+	contract, err := bindAuthority(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthorityTransactor{contract: contract}, nil
+}
+
+// NewAuthorityFilterer creates a new log filterer instance of Authority, bound to a specific deployed contract.
+func NewAuthorityFilterer(address common.Address, filterer bind.ContractFilterer) (*AuthorityFilterer, error) {
+	contract, err := bindAuthority(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthorityFilterer{contract: contract}, nil
+}
+
+// bindAuthority binds a generic wrapper to an already deployed contract.
+func bindAuthority(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AuthorityMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Authority *AuthorityRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Authority.Contract.AuthorityCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Authority *AuthorityRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Authority.Contract.AuthorityTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Authority *AuthorityRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Authority.Contract.AuthorityTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Authority *AuthorityCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Authority.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Authority *AuthorityTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Authority.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Authority *AuthorityTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Authority.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetClaim is a free data retrieval call binding the contract method 0xd79a8240.
+//
+// Solidity: function getClaim(address _dapp, bytes _proofContext) view returns(bytes32, uint256, uint256)
+func (_Authority *AuthorityCaller) GetClaim(opts *bind.CallOpts, _dapp common.Address, _proofContext []byte) ([32]byte, *big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _Authority.contract.Call(opts, &out, "getClaim", _dapp, _proofContext)
+
+	if err != nil {
+		return *new([32]byte), *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, err
+
+}
+
+// GetClaim is a free data retrieval call binding the contract method 0xd79a8240.
+//
+// Solidity: function getClaim(address _dapp, bytes _proofContext) view returns(bytes32, uint256, uint256)
+func (_Authority *AuthoritySession) GetClaim(_dapp common.Address, _proofContext []byte) ([32]byte, *big.Int, *big.Int, error) {
+	return _Authority.Contract.GetClaim(&_Authority.CallOpts, _dapp, _proofContext)
+}
+
+// GetClaim is a free data retrieval call binding the contract method 0xd79a8240.
+//
+// Solidity: function getClaim(address _dapp, bytes _proofContext) view returns(bytes32, uint256, uint256)
+func (_Authority *AuthorityCallerSession) GetClaim(_dapp common.Address, _proofContext []byte) ([32]byte, *big.Int, *big.Int, error) {
+	return _Authority.Contract.GetClaim(&_Authority.CallOpts, _dapp, _proofContext)
+}
+
+// GetHistory is a free data retrieval call binding the contract method 0xaa15efc8.
+//
+// Solidity: function getHistory() view returns(address)
+func (_Authority *AuthorityCaller) GetHistory(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Authority.contract.Call(opts, &out, "getHistory")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetHistory is a free data retrieval call binding the contract method 0xaa15efc8.
+//
+// Solidity: function getHistory() view returns(address)
+func (_Authority *AuthoritySession) GetHistory() (common.Address, error) {
+	return _Authority.Contract.GetHistory(&_Authority.CallOpts)
+}
+
+// GetHistory is a free data retrieval call binding the contract method 0xaa15efc8.
+//
+// Solidity: function getHistory() view returns(address)
+func (_Authority *AuthorityCallerSession) GetHistory() (common.Address, error) {
+	return _Authority.Contract.GetHistory(&_Authority.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Authority *AuthorityCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Authority.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Authority *AuthoritySession) Owner() (common.Address, error) {
+	return _Authority.Contract.Owner(&_Authority.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Authority *AuthorityCallerSession) Owner() (common.Address, error) {
+	return _Authority.Contract.Owner(&_Authority.CallOpts)
+}
+
+// Join is a paid mutator transaction binding the contract method 0xb688a363.
+//
+// Solidity: function join() returns()
+func (_Authority *AuthorityTransactor) Join(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Authority.contract.Transact(opts, "join")
+}
+
+// Join is a paid mutator transaction binding the contract method 0xb688a363.
+//
+// Solidity: function join() returns()
+func (_Authority *AuthoritySession) Join() (*types.Transaction, error) {
+	return _Authority.Contract.Join(&_Authority.TransactOpts)
+}
+
+// Join is a paid mutator transaction binding the contract method 0xb688a363.
+//
+// Solidity: function join() returns()
+func (_Authority *AuthorityTransactorSession) Join() (*types.Transaction, error) {
+	return _Authority.Contract.Join(&_Authority.TransactOpts)
+}
+
+// MigrateHistoryToConsensus is a paid mutator transaction binding the contract method 0x9368a3d3.
+//
+// Solidity: function migrateHistoryToConsensus(address _consensus) returns()
+func (_Authority *AuthorityTransactor) MigrateHistoryToConsensus(opts *bind.TransactOpts, _consensus common.Address) (*types.Transaction, error) {
+	return _Authority.contract.Transact(opts, "migrateHistoryToConsensus", _consensus)
+}
+
+// MigrateHistoryToConsensus is a paid mutator transaction binding the contract method 0x9368a3d3.
+//
+// Solidity: function migrateHistoryToConsensus(address _consensus) returns()
+func (_Authority *AuthoritySession) MigrateHistoryToConsensus(_consensus common.Address) (*types.Transaction, error) {
+	return _Authority.Contract.MigrateHistoryToConsensus(&_Authority.TransactOpts, _consensus)
+}
+
+// MigrateHistoryToConsensus is a paid mutator transaction binding the contract method 0x9368a3d3.
+//
+// Solidity: function migrateHistoryToConsensus(address _consensus) returns()
+func (_Authority *AuthorityTransactorSession) MigrateHistoryToConsensus(_consensus common.Address) (*types.Transaction, error) {
+	return _Authority.Contract.MigrateHistoryToConsensus(&_Authority.TransactOpts, _consensus)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Authority *AuthorityTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Authority.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Authority *AuthoritySession) RenounceOwnership() (*types.Transaction, error) {
+	return _Authority.Contract.RenounceOwnership(&_Authority.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Authority *AuthorityTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Authority.Contract.RenounceOwnership(&_Authority.TransactOpts)
+}
+
+// SetHistory is a paid mutator transaction binding the contract method 0x159c5ea1.
+//
+// Solidity: function setHistory(address _history) returns()
+func (_Authority *AuthorityTransactor) SetHistory(opts *bind.TransactOpts, _history common.Address) (*types.Transaction, error) {
+	return _Authority.contract.Transact(opts, "setHistory", _history)
+}
+
+// SetHistory is a paid mutator transaction binding the contract method 0x159c5ea1.
+//
+// Solidity: function setHistory(address _history) returns()
+func (_Authority *AuthoritySession) SetHistory(_history common.Address) (*types.Transaction, error) {
+	return _Authority.Contract.SetHistory(&_Authority.TransactOpts, _history)
+}
+
+// SetHistory is a paid mutator transaction binding the contract method 0x159c5ea1.
+//
+// Solidity: function setHistory(address _history) returns()
+func (_Authority *AuthorityTransactorSession) SetHistory(_history common.Address) (*types.Transaction, error) {
+	return _Authority.Contract.SetHistory(&_Authority.TransactOpts, _history)
+}
+
+// SubmitClaim is a paid mutator transaction binding the contract method 0xddfdfbb0.
+//
+// Solidity: function submitClaim(bytes _claimData) returns()
+func (_Authority *AuthorityTransactor) SubmitClaim(opts *bind.TransactOpts, _claimData []byte) (*types.Transaction, error) {
+	return _Authority.contract.Transact(opts, "submitClaim", _claimData)
+}
+
+// SubmitClaim is a paid mutator transaction binding the contract method 0xddfdfbb0.
+//
+// Solidity: function submitClaim(bytes _claimData) returns()
+func (_Authority *AuthoritySession) SubmitClaim(_claimData []byte) (*types.Transaction, error) {
+	return _Authority.Contract.SubmitClaim(&_Authority.TransactOpts, _claimData)
+}
+
+// SubmitClaim is a paid mutator transaction binding the contract method 0xddfdfbb0.
+//
+// Solidity: function submitClaim(bytes _claimData) returns()
+func (_Authority *AuthorityTransactorSession) SubmitClaim(_claimData []byte) (*types.Transaction, error) {
+	return _Authority.Contract.SubmitClaim(&_Authority.TransactOpts, _claimData)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Authority *AuthorityTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Authority.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Authority *AuthoritySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Authority.Contract.TransferOwnership(&_Authority.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Authority *AuthorityTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Authority.Contract.TransferOwnership(&_Authority.TransactOpts, newOwner)
+}
+
+// AuthorityApplicationJoinedIterator is returned from FilterApplicationJoined and is used to iterate over the raw logs and unpacked data for ApplicationJoined events raised by the Authority contract.
+type AuthorityApplicationJoinedIterator struct {
+	Event *AuthorityApplicationJoined // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuthorityApplicationJoinedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuthorityApplicationJoined)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuthorityApplicationJoined)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuthorityApplicationJoinedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuthorityApplicationJoinedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuthorityApplicationJoined represents a ApplicationJoined event raised by the Authority contract.
+type AuthorityApplicationJoined struct {
+	Application common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterApplicationJoined is a free log retrieval operation binding the contract event 0x27c2b702d3bff195a18baca2daf00b20a986177c5f1449af4e2d46a3c3e02ce5.
+//
+// Solidity: event ApplicationJoined(address indexed application)
+func (_Authority *AuthorityFilterer) FilterApplicationJoined(opts *bind.FilterOpts, application []common.Address) (*AuthorityApplicationJoinedIterator, error) {
+
+	var applicationRule []interface{}
+	for _, applicationItem := range application {
+		applicationRule = append(applicationRule, applicationItem)
+	}
+
+	logs, sub, err := _Authority.contract.FilterLogs(opts, "ApplicationJoined", applicationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthorityApplicationJoinedIterator{contract: _Authority.contract, event: "ApplicationJoined", logs: logs, sub: sub}, nil
+}
+
+// WatchApplicationJoined is a free log subscription operation binding the contract event 0x27c2b702d3bff195a18baca2daf00b20a986177c5f1449af4e2d46a3c3e02ce5.
+//
+// Solidity: event ApplicationJoined(address indexed application)
+func (_Authority *AuthorityFilterer) WatchApplicationJoined(opts *bind.WatchOpts, sink chan<- *AuthorityApplicationJoined, application []common.Address) (event.Subscription, error) {
+
+	var applicationRule []interface{}
+	for _, applicationItem := range application {
+		applicationRule = append(applicationRule, applicationItem)
+	}
+
+	logs, sub, err := _Authority.contract.WatchLogs(opts, "ApplicationJoined", applicationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuthorityApplicationJoined)
+				if err := _Authority.contract.UnpackLog(event, "ApplicationJoined", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApplicationJoined is a log parse operation binding the contract event 0x27c2b702d3bff195a18baca2daf00b20a986177c5f1449af4e2d46a3c3e02ce5.
+//
+// Solidity: event ApplicationJoined(address indexed application)
+func (_Authority *AuthorityFilterer) ParseApplicationJoined(log types.Log) (*AuthorityApplicationJoined, error) {
+	event := new(AuthorityApplicationJoined)
+	if err := _Authority.contract.UnpackLog(event, "ApplicationJoined", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuthorityNewHistoryIterator is returned from FilterNewHistory and is used to iterate over the raw logs and unpacked data for NewHistory events raised by the Authority contract.
+type AuthorityNewHistoryIterator struct {
+	Event *AuthorityNewHistory // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuthorityNewHistoryIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuthorityNewHistory)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuthorityNewHistory)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuthorityNewHistoryIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuthorityNewHistoryIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuthorityNewHistory represents a NewHistory event raised by the Authority contract.
+type AuthorityNewHistory struct {
+	History common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterNewHistory is a free log retrieval operation binding the contract event 0x2bcd43869347a1d42f97ac6042f3d129817abd05a6125f9750fe3724e321d23e.
+//
+// Solidity: event NewHistory(address history)
+func (_Authority *AuthorityFilterer) FilterNewHistory(opts *bind.FilterOpts) (*AuthorityNewHistoryIterator, error) {
+
+	logs, sub, err := _Authority.contract.FilterLogs(opts, "NewHistory")
+	if err != nil {
+		return nil, err
+	}
+	return &AuthorityNewHistoryIterator{contract: _Authority.contract, event: "NewHistory", logs: logs, sub: sub}, nil
+}
+
+// WatchNewHistory is a free log subscription operation binding the contract event 0x2bcd43869347a1d42f97ac6042f3d129817abd05a6125f9750fe3724e321d23e.
+//
+// Solidity: event NewHistory(address history)
+func (_Authority *AuthorityFilterer) WatchNewHistory(opts *bind.WatchOpts, sink chan<- *AuthorityNewHistory) (event.Subscription, error) {
+
+	logs, sub, err := _Authority.contract.WatchLogs(opts, "NewHistory")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuthorityNewHistory)
+				if err := _Authority.contract.UnpackLog(event, "NewHistory", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNewHistory is a log parse operation binding the contract event 0x2bcd43869347a1d42f97ac6042f3d129817abd05a6125f9750fe3724e321d23e.
+//
+// Solidity: event NewHistory(address history)
+func (_Authority *AuthorityFilterer) ParseNewHistory(log types.Log) (*AuthorityNewHistory, error) {
+	event := new(AuthorityNewHistory)
+	if err := _Authority.contract.UnpackLog(event, "NewHistory", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuthorityOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Authority contract.
+type AuthorityOwnershipTransferredIterator struct {
+	Event *AuthorityOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuthorityOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuthorityOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuthorityOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuthorityOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuthorityOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuthorityOwnershipTransferred represents a OwnershipTransferred event raised by the Authority contract.
+type AuthorityOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Authority *AuthorityFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*AuthorityOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Authority.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthorityOwnershipTransferredIterator{contract: _Authority.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Authority *AuthorityFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *AuthorityOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Authority.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuthorityOwnershipTransferred)
+				if err := _Authority.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Authority *AuthorityFilterer) ParseOwnershipTransferred(log types.Log) (*AuthorityOwnershipTransferred, error) {
+	event := new(AuthorityOwnershipTransferred)
+	if err := _Authority.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
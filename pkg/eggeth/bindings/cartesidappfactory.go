@@ -0,0 +1,423 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CartesiDAppFactoryMetaData contains all meta data concerning the CartesiDAppFactory contract.
+var CartesiDAppFactoryMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"contractIConsensus\",\"name\":\"consensus\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"dappOwner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"templateHash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"contractCartesiDApp\",\"name\":\"application\",\"type\":\"address\"}],\"name\":\"ApplicationCreated\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"contractIConsensus\",\"name\":\"_consensus\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_dappOwner\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"_templateHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"_salt\",\"type\":\"bytes32\"}],\"name\":\"calculateApplicationAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIConsensus\",\"name\":\"_consensus\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_dappOwner\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"_templateHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"_salt\",\"type\":\"bytes32\"}],\"name\":\"newApplication\",\"outputs\":[{\"internalType\":\"contractCartesiDApp\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIConsensus\",\"name\":\"_consensus\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_dappOwner\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"_templateHash\",\"type\":\"bytes32\"}],\"name\":\"newApplication\",\"outputs\":[{\"internalType\":\"contractCartesiDApp\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b50611ca2806100206000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c80630e1a07f5146100465780633648bfb514610075578063bd4f121914610088575b600080fd5b6100596100543660046102ea565b61009b565b6040516001600160a01b03909116815260200160405180910390f35b610059610083366004610330565b610148565b6100596100963660046102ea565b6101ef565b600080828686866040516100ae906102c5565b6001600160a01b03938416815292909116602083015260408201526060018190604051809103906000f59050801580156100ec573d6000803e3d6000fd5b50604080516001600160a01b03888116825260208201889052838116828401529151929350908816917fe73165c2d277daf8713fd08b40845cb6bb7a20b2b543f3d35324a475660fcebd9181900360600190a295945050505050565b60008084848460405161015a906102c5565b6001600160a01b0393841681529290911660208301526040820152606001604051809103906000f080158015610194573d6000803e3d6000fd5b50604080516001600160a01b03878116825260208201879052838116828401529151929350908716917fe73165c2d277daf8713fd08b40845cb6bb7a20b2b543f3d35324a475660fcebd9181900360600190a2949350505050565b60008060405180602001610202906102c5565b601f1982820381018352601f9091011660408181526001600160a01b0389811660208401528816908201526060810186905260800160408051601f198184030181529082905261025592916020016103a1565b60408051601f1981840301815282825280516020918201206001600160f81b0319828501523060601b6bffffffffffffffffffffffff1916602185015260358401969096526055808401969096528151808403909601865260759092019052835193019290922095945050505050565b6118ae806103bf83390190565b6001600160a01b03811681146102e757600080fd5b50565b6000806000806080858703121561030057600080fd5b843561030b816102d2565b9350602085013561031b816102d2565b93969395505050506040820135916060013590565b60008060006060848603121561034557600080fd5b8335610350816102d2565b92506020840135610360816102d2565b929592945050506040919091013590565b6000815160005b818110156103925760208185018101518683015201610378565b50600093019283525090919050565b60006103b66103b08386610371565b84610371565b94935050505056fe60a060405260016000553480156200001657600080fd5b50604051620018ae380380620018ae833981016040819052620000399162000209565b6200004433620000cc565b6200004f826200011e565b6080819052600380546001600160a01b0319166001600160a01b0385169081179091556040805163b688a36360e01b8152905163b688a3639160048082019260009290919082900301818387803b158015620000aa57600080fd5b505af1158015620000bf573d6000803e3d6000fd5b5050505050505062000251565b600180546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b6001546001600160a01b031633146200017e5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064015b60405180910390fd5b6001600160a01b038116620001e55760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b606482015260840162000175565b620001f081620000cc565b50565b6001600160a01b0381168114620001f057600080fd5b6000806000606084860312156200021f57600080fd5b83516200022c81620001f3565b60208501519093506200023f81620001f3565b80925050604084015190509250925092565b6080516116416200026d60003960006101ed01526116416000f3fe6080604052600436106100e15760003560e01c80638da5cb5b1161007f578063bc197c8111610059578063bc197c8114610289578063f23a6e61146102b5578063f2fde38b146102e1578063fc4116831461030157600080fd5b80638da5cb5b1461022b57806396487d46146102495780639d9b11451461026957600080fd5b8063179e740b116100bb578063179e740b14610187578063522f6815146101b957806361b12c66146101db578063715018a61461021657600080fd5b806301ffc9a7146100ed5780631250482f14610122578063150b7a021461014257600080fd5b366100e857005b600080fd5b3480156100f957600080fd5b5061010d610108366004610f2b565b610321565b60405190151581526020015b60405180910390f35b34801561012e57600080fd5b5061010d61013d366004610fca565b61034c565b34801561014e57600080fd5b5061016e61015d366004611045565b630a85bd0160e11b95945050505050565b6040516001600160e01b03199091168152602001610119565b34801561019357600080fd5b506003546001600160a01b03165b6040516001600160a01b039091168152602001610119565b3480156101c557600080fd5b506101d96101d43660046110b7565b610516565b005b3480156101e757600080fd5b506040517f00000000000000000000000000000000000000000000000000000000000000008152602001610119565b34801561022257600080fd5b506101d96105af565b34801561023757600080fd5b506001546001600160a01b03166101a1565b34801561025557600080fd5b5061010d6102643660046110e3565b6105e5565b34801561027557600080fd5b5061010d61028436600461114b565b610638565b34801561029557600080fd5b5061016e6102a43660046112a1565b63bc197c8160e01b95945050505050565b3480156102c157600080fd5b5061016e6102d036600461134e565b63f23a6e6160e01b95945050505050565b3480156102ed57600080fd5b506101d96102fc3660046113b6565b610652565b34801561030d57600080fd5b506101d961031c3660046113b6565b6106ed565b60006001600160e01b03198216630a85bd0160e11b14806103465750610346826107c4565b92915050565b600080546002036103a45760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c0060448201526064015b60405180910390fd5b6002600090815580806103c26103bd60208701876113d3565b6107fa565b9194509250905060006103e183836103da8980611419565b9190610881565b90506103fd898989876103f48b80611419565b939291906108c7565b600061043161040c8880611419565b61041d906040810190602001611439565b6001600160401b03168360809190911b1790565b905061043c8161090b565b1561045a576040516370de22b760e01b815260040160405180910390fd5b60008a6001600160a01b03168a8a604051610476929190611462565b6000604051808303816000865af19150503d80600081146104b3576040519150601f19603f3d011682016040523d82523d6000602084013e6104b8565b606091505b505090508015610503576104cf600283600161092e565b6040518281527f0eb7ee080f865f1cadc4f54daf58cc3b8879e888832867d13351edcec0fbdc549060200160405180910390a15b60016000559a9950505050505050505050565b3330146105365760405163a08d601d60e01b815260040160405180910390fd5b6000826001600160a01b03168260405160006040518083038185875af1925050503d8060008114610583576040519150601f19603f3d011682016040523d82523d6000602084013e610588565b606091505b50509050806105aa57604051630ce8f45160e31b815260040160405180910390fd5b505050565b6001546001600160a01b031633146105d95760405162461bcd60e51b815260040161039b90611472565b6105e36000610976565b565b60008080806105fa6103bd60208701876113d3565b9194509250905061061082826103da8880611419565b506106298787856106218980611419565b9291906109c8565b600193505050505b9392505050565b6000608082901b831761064a8161090b565b949350505050565b6001546001600160a01b0316331461067c5760405162461bcd60e51b815260040161039b90611472565b6001600160a01b0381166106e15760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b606482015260840161039b565b6106ea81610976565b50565b6001546001600160a01b031633146107175760405162461bcd60e51b815260040161039b90611472565b600380546001600160a01b0319166001600160a01b0383169081179091556040805163b688a36360e01b8152905163b688a3639160048082019260009290919082900301818387803b15801561076c57600080fd5b505af1158015610780573d6000803e3d6000fd5b50506040516001600160a01b03841681527f4991c6f37185659e276ff918a96f3e20e6c5abcd8c9aab450dc19c2f7ad35cb59250602001905060405180910390a150565b60006001600160e01b03198216630271189760e51b148061034657506001600160e01b031982166301ffc9a760e01b1492915050565b60035460405163035e6a0960e61b8152600091829182916001600160a01b03169063d79a824090610833903090899089906004016114d0565b606060405180830381865afa158015610850573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061087491906114fe565b9250925092509250925092565b6000806108916020860186611439565b6108a4906001600160401b031685611542565b90508281111561064a5760405163fd9ae91f60e01b815260040160405180910390fd5b60008484846040516020016108de939291906114d0565b60405160208183030381529060405290506109038682848960600135602560156109fe565b505050505050565b600881901c600090815260026020526040812054600160ff84161b161515610346565b600882901c600160ff84161b821561095957600082815260208690526040902080548217905561096f565b6000828152602086905260409020805482191690555b5050505050565b600180546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b600083836040516020016109dd929190611555565b604051602081830303815290604052905061096f8582848860800135602560155b6040805160608089013560208301526080808a01359383019390935260a0890135908201528591016040516020818303038152906040528051906020012014610a5a57604051636fbd3b7160e11b815260040160405180910390fd5b82610a97610a7d610a6e60208a018a611439565b6001600160401b031660051b90565b60058560408b0135610a9260e08d018d611569565b610b40565b14610ab557604051634371daa160e11b815260040160405180910390fd5b6000610aea8680519060200120604051602001610ad491815260200190565b6040516020818303038152906040526005610d56565b905060408701803590610b1990610b0890610a6e9060208c01611439565b60058585610a9260c08e018e611569565b14610b375760405163017c689560e61b815260040160405180910390fd5b50505050505050565b6000858510158015610b53575060038610155b8015610b60575060408511155b610bca5760405162461bcd60e51b815260206004820152603560248201527f33203c3d206c6f6753697a654f665265706c6163656d656e74203c3d206c6f6760448201527414da5e9953d9919d5b1b111c9a5d99480f0f480d8d605a1b606482015260840161039b565b600180871b908890610bdc90836115b2565b1615610c2a5760405162461bcd60e51b815260206004820152601760248201527f506f736974696f6e206973206e6f7420616c69676e6564000000000000000000604482015260640161039b565b610c3487876115b2565b8314610c825760405162461bcd60e51b815260206004820152601b60248201527f50726f6f66206c656e67746820646f6573206e6f74206d617463680000000000604482015260640161039b565b60005b83811015610d495781811b8916600003610cea5785858583818110610cac57610cac6115c5565b90506020020135604051602001610ccd929190918252602082015260400190565b604051602081830303815290604052805190602001209550610d37565b848482818110610cfc57610cfc6115c5565b9050602002013586604051602001610d1e929190918252602082015260400190565b6040516020818303038152906040528051906020012095505b80610d41816115db565b915050610c85565b5093979650505050505050565b600060038210158015610d6a575060408211155b610dac5760405162461bcd60e51b81526020600482015260136024820152720cc80f0f481b1bd9cc94da5e99480f0f480d8d606a1b604482015260640161039b565b816001901b83511115610e015760405162461bcd60e51b815260206004820152601960248201527f6461746120697320626967676572207468616e20647269766500000000000000604482015260640161039b565b610631836000845b600060038203610eb6576000805b6008811015610e81578551610e2c8287611542565b1015610e6f57610e3d8160086115f4565b86610e488388611542565b81518110610e5857610e586115c5565b01602001516001600160f81b031916901c91909117905b80610e79816115db565b915050610e17565b506040516001600160c01b03198216602082015260280160405160208183030381529060405280519060200120915050610631565b6000610ec36001846115b2565b6001901b9050610ee08585600186610edb91906115b2565b610e09565b610ef986610eee8488611542565b610edb6001886115b2565b604080516020810193909352820152606001604051602081830303815290604052805190602001209150509392505050565b600060208284031215610f3d57600080fd5b81356001600160e01b03198116811461063157600080fd5b6001600160a01b03811681146106ea57600080fd5b60008083601f840112610f7c57600080fd5b5081356001600160401b03811115610f9357600080fd5b602083019150836020828501011115610fab57600080fd5b9250929050565b600060408284031215610fc457600080fd5b50919050565b60008060008060608587031215610fe057600080fd5b8435610feb81610f55565b935060208501356001600160401b038082111561100757600080fd5b61101388838901610f6a565b9095509350604087013591508082111561102c57600080fd5b5061103987828801610fb2565b91505092959194509250565b60008060008060006080868803121561105d57600080fd5b853561106881610f55565b9450602086013561107881610f55565b93506040860135925060608601356001600160401b0381111561109a57600080fd5b6110a688828901610f6a565b969995985093965092949392505050565b600080604083850312156110ca57600080fd5b82356110d581610f55565b946020939093013593505050565b6000806000604084860312156110f857600080fd5b83356001600160401b038082111561110f57600080fd5b61111b87838801610f6a565b9095509350602086013591508082111561113457600080fd5b5061114186828701610fb2565b9150509250925092565b6000806040838503121561115e57600080fd5b50508035926020909101359150565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f191681016001600160401b03811182821017156111ab576111ab61116d565b604052919050565b600082601f8301126111c457600080fd5b813560206001600160401b038211156111df576111df61116d565b8160051b6111ee828201611183565b928352848101820192828101908785111561120857600080fd5b83870192505b848310156112275782358252918301919083019061120e565b979650505050505050565b600082601f83011261124357600080fd5b81356001600160401b0381111561125c5761125c61116d565b61126f601f8201601f1916602001611183565b81815284602083860101111561128457600080fd5b816020850160208301376000918101602001919091529392505050565b600080600080600060a086880312156112b957600080fd5b85356112c481610f55565b945060208601356112d481610f55565b935060408601356001600160401b03808211156112f057600080fd5b6112fc89838a016111b3565b9450606088013591508082111561131257600080fd5b61131e89838a016111b3565b9350608088013591508082111561133457600080fd5b5061134188828901611232565b9150509295509295909350565b600080600080600060a0868803121561136657600080fd5b853561137181610f55565b9450602086013561138181610f55565b9350604086013592506060860135915060808601356001600160401b038111156113aa57600080fd5b61134188828901611232565b6000602082840312156113c857600080fd5b813561063181610f55565b6000808335601e198436030181126113ea57600080fd5b8301803591506001600160401b0382111561140457600080fd5b602001915036819003821315610fab57600080fd5b6000823560fe1983360301811261142f57600080fd5b9190910192915050565b60006020828403121561144b57600080fd5b81356001600160401b038116811461063157600080fd5b8183823760009101908152919050565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b6001600160a01b03841681526040602082018190526000906114f590830184866114a7565b95945050505050565b60008060006060848603121561151357600080fd5b8351925060208401519150604084015190509250925092565b634e487b7160e01b600052601160045260246000fd5b808201808211156103465761034661152c565b60208152600061064a6020830184866114a7565b6000808335601e1984360301811261158057600080fd5b8301803591506001600160401b0382111561159a57600080fd5b6020019150600581901b3603821315610fab57600080fd5b818103818111156103465761034661152c565b634e487b7160e01b600052603260045260246000fd5b6000600182016115ed576115ed61152c565b5060010190565b80820281158282048414176103465761034661152c56fea26469706673582212202db22415313936dac8859a31c393a553eb45f77a21e814ee66d86afb22b50dd164736f6c63430008150033a26469706673582212209b8d687a7dc8cc28526acafee0a0f4a236ab5b8b7e7dc44e142cf0584a3fc5e564736f6c63430008150033",
+}
+
+// CartesiDAppFactoryABI is the input ABI used to generate the binding from.
+// Deprecated: Use CartesiDAppFactoryMetaData.ABI instead.
+var CartesiDAppFactoryABI = CartesiDAppFactoryMetaData.ABI
+
+// CartesiDAppFactoryBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use CartesiDAppFactoryMetaData.Bin instead.
+var CartesiDAppFactoryBin = CartesiDAppFactoryMetaData.Bin
+
+// DeployCartesiDAppFactory deploys a new Ethereum contract, binding an instance of CartesiDAppFactory to it.
+func DeployCartesiDAppFactory(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *CartesiDAppFactory, error) {
+	parsed, err := CartesiDAppFactoryMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(CartesiDAppFactoryBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &CartesiDAppFactory{CartesiDAppFactoryCaller: CartesiDAppFactoryCaller{contract: contract}, CartesiDAppFactoryTransactor: CartesiDAppFactoryTransactor{contract: contract}, CartesiDAppFactoryFilterer: CartesiDAppFactoryFilterer{contract: contract}}, nil
+}
+
+// CartesiDAppFactory is an auto generated Go binding around an Ethereum contract.
+type CartesiDAppFactory struct {
+	CartesiDAppFactoryCaller     // Read-only binding to the contract
+	CartesiDAppFactoryTransactor // Write-only binding to the contract
+	CartesiDAppFactoryFilterer   // Log filterer for contract events
+}
+
+// CartesiDAppFactoryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CartesiDAppFactoryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CartesiDAppFactoryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CartesiDAppFactoryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CartesiDAppFactoryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CartesiDAppFactoryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CartesiDAppFactorySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CartesiDAppFactorySession struct {
+	Contract     *CartesiDAppFactory // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// CartesiDAppFactoryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CartesiDAppFactoryCallerSession struct {
+	Contract *CartesiDAppFactoryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// CartesiDAppFactoryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CartesiDAppFactoryTransactorSession struct {
+	Contract     *CartesiDAppFactoryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// CartesiDAppFactoryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CartesiDAppFactoryRaw struct {
+	Contract *CartesiDAppFactory // Generic contract binding to access the raw methods on
+}
+
+// CartesiDAppFactoryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CartesiDAppFactoryCallerRaw struct {
+	Contract *CartesiDAppFactoryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CartesiDAppFactoryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CartesiDAppFactoryTransactorRaw struct {
+	Contract *CartesiDAppFactoryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCartesiDAppFactory creates a new instance of CartesiDAppFactory, bound to a specific deployed contract.
+func NewCartesiDAppFactory(address common.Address, backend bind.ContractBackend) (*CartesiDAppFactory, error) {
+	contract, err := bindCartesiDAppFactory(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CartesiDAppFactory{CartesiDAppFactoryCaller: CartesiDAppFactoryCaller{contract: contract}, CartesiDAppFactoryTransactor: CartesiDAppFactoryTransactor{contract: contract}, CartesiDAppFactoryFilterer: CartesiDAppFactoryFilterer{contract: contract}}, nil
+}
+
+// NewCartesiDAppFactoryCaller creates a new read-only instance of CartesiDAppFactory, bound to a specific deployed contract.
+func NewCartesiDAppFactoryCaller(address common.Address, caller bind.ContractCaller) (*CartesiDAppFactoryCaller, error) {
+	contract, err := bindCartesiDAppFactory(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CartesiDAppFactoryCaller{contract: contract}, nil
+}
+
+// NewCartesiDAppFactoryTransactor creates a new write-only instance of CartesiDAppFactory, bound to a specific deployed contract.
+func NewCartesiDAppFactoryTransactor(address common.Address, transactor bind.ContractTransactor) (*CartesiDAppFactoryTransactor, error) {
+	contract, err := bindCartesiDAppFactory(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CartesiDAppFactoryTransactor{contract: contract}, nil
+}
+
+// NewCartesiDAppFactoryFilterer creates a new log filterer instance of CartesiDAppFactory, bound to a specific deployed contract.
+func NewCartesiDAppFactoryFilterer(address common.Address, filterer bind.ContractFilterer) (*CartesiDAppFactoryFilterer, error) {
+	contract, err := bindCartesiDAppFactory(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CartesiDAppFactoryFilterer{contract: contract}, nil
+}
+
+// bindCartesiDAppFactory binds a generic wrapper to an already deployed contract.
+func bindCartesiDAppFactory(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CartesiDAppFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CartesiDAppFactory *CartesiDAppFactoryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CartesiDAppFactory.Contract.CartesiDAppFactoryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CartesiDAppFactory *CartesiDAppFactoryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CartesiDAppFactory.Contract.CartesiDAppFactoryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CartesiDAppFactory *CartesiDAppFactoryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CartesiDAppFactory.Contract.CartesiDAppFactoryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CartesiDAppFactory *CartesiDAppFactoryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CartesiDAppFactory.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CartesiDAppFactory *CartesiDAppFactoryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CartesiDAppFactory.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CartesiDAppFactory *CartesiDAppFactoryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CartesiDAppFactory.Contract.contract.Transact(opts, method, params...)
+}
+
+// CalculateApplicationAddress is a free data retrieval call binding the contract method 0xbd4f1219.
+//
+// Solidity: function calculateApplicationAddress(address _consensus, address _dappOwner, bytes32 _templateHash, bytes32 _salt) view returns(address)
+func (_CartesiDAppFactory *CartesiDAppFactoryCaller) CalculateApplicationAddress(opts *bind.CallOpts, _consensus common.Address, _dappOwner common.Address, _templateHash [32]byte, _salt [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _CartesiDAppFactory.contract.Call(opts, &out, "calculateApplicationAddress", _consensus, _dappOwner, _templateHash, _salt)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// CalculateApplicationAddress is a free data retrieval call binding the contract method 0xbd4f1219.
+//
+// Solidity: function calculateApplicationAddress(address _consensus, address _dappOwner, bytes32 _templateHash, bytes32 _salt) view returns(address)
+func (_CartesiDAppFactory *CartesiDAppFactorySession) CalculateApplicationAddress(_consensus common.Address, _dappOwner common.Address, _templateHash [32]byte, _salt [32]byte) (common.Address, error) {
+	return _CartesiDAppFactory.Contract.CalculateApplicationAddress(&_CartesiDAppFactory.CallOpts, _consensus, _dappOwner, _templateHash, _salt)
+}
+
+// CalculateApplicationAddress is a free data retrieval call binding the contract method 0xbd4f1219.
+//
+// Solidity: function calculateApplicationAddress(address _consensus, address _dappOwner, bytes32 _templateHash, bytes32 _salt) view returns(address)
+func (_CartesiDAppFactory *CartesiDAppFactoryCallerSession) CalculateApplicationAddress(_consensus common.Address, _dappOwner common.Address, _templateHash [32]byte, _salt [32]byte) (common.Address, error) {
+	return _CartesiDAppFactory.Contract.CalculateApplicationAddress(&_CartesiDAppFactory.CallOpts, _consensus, _dappOwner, _templateHash, _salt)
+}
+
+// NewApplication is a paid mutator transaction binding the contract method 0x0e1a07f5.
+//
+// Solidity: function newApplication(address _consensus, address _dappOwner, bytes32 _templateHash, bytes32 _salt) returns(address)
+func (_CartesiDAppFactory *CartesiDAppFactoryTransactor) NewApplication(opts *bind.TransactOpts, _consensus common.Address, _dappOwner common.Address, _templateHash [32]byte, _salt [32]byte) (*types.Transaction, error) {
+	return _CartesiDAppFactory.contract.Transact(opts, "newApplication", _consensus, _dappOwner, _templateHash, _salt)
+}
+
+// NewApplication is a paid mutator transaction binding the contract method 0x0e1a07f5.
+//
+// Solidity: function newApplication(address _consensus, address _dappOwner, bytes32 _templateHash, bytes32 _salt) returns(address)
+func (_CartesiDAppFactory *CartesiDAppFactorySession) NewApplication(_consensus common.Address, _dappOwner common.Address, _templateHash [32]byte, _salt [32]byte) (*types.Transaction, error) {
+	return _CartesiDAppFactory.Contract.NewApplication(&_CartesiDAppFactory.TransactOpts, _consensus, _dappOwner, _templateHash, _salt)
+}
+
+// NewApplication is a paid mutator transaction binding the contract method 0x0e1a07f5.
+//
+// Solidity: function newApplication(address _consensus, address _dappOwner, bytes32 _templateHash, bytes32 _salt) returns(address)
+func (_CartesiDAppFactory *CartesiDAppFactoryTransactorSession) NewApplication(_consensus common.Address, _dappOwner common.Address, _templateHash [32]byte, _salt [32]byte) (*types.Transaction, error) {
+	return _CartesiDAppFactory.Contract.NewApplication(&_CartesiDAppFactory.TransactOpts, _consensus, _dappOwner, _templateHash, _salt)
+}
+
+// NewApplication0 is a paid mutator transaction binding the contract method 0x3648bfb5.
+//
+// Solidity: function newApplication(address _consensus, address _dappOwner, bytes32 _templateHash) returns(address)
+func (_CartesiDAppFactory *CartesiDAppFactoryTransactor) NewApplication0(opts *bind.TransactOpts, _consensus common.Address, _dappOwner common.Address, _templateHash [32]byte) (*types.Transaction, error) {
+	return _CartesiDAppFactory.contract.Transact(opts, "newApplication0", _consensus, _dappOwner, _templateHash)
+}
+
+// NewApplication0 is a paid mutator transaction binding the contract method 0x3648bfb5.
+//
+// Solidity: function newApplication(address _consensus, address _dappOwner, bytes32 _templateHash) returns(address)
+func (_CartesiDAppFactory *CartesiDAppFactorySession) NewApplication0(_consensus common.Address, _dappOwner common.Address, _templateHash [32]byte) (*types.Transaction, error) {
+	return _CartesiDAppFactory.Contract.NewApplication0(&_CartesiDAppFactory.TransactOpts, _consensus, _dappOwner, _templateHash)
+}
+
+// NewApplication0 is a paid mutator transaction binding the contract method 0x3648bfb5.
+//
+// Solidity: function newApplication(address _consensus, address _dappOwner, bytes32 _templateHash) returns(address)
+func (_CartesiDAppFactory *CartesiDAppFactoryTransactorSession) NewApplication0(_consensus common.Address, _dappOwner common.Address, _templateHash [32]byte) (*types.Transaction, error) {
+	return _CartesiDAppFactory.Contract.NewApplication0(&_CartesiDAppFactory.TransactOpts, _consensus, _dappOwner, _templateHash)
+}
+
+// CartesiDAppFactoryApplicationCreatedIterator is returned from FilterApplicationCreated and is used to iterate over the raw logs and unpacked data for ApplicationCreated events raised by the CartesiDAppFactory contract.
+type CartesiDAppFactoryApplicationCreatedIterator struct {
+	Event *CartesiDAppFactoryApplicationCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CartesiDAppFactoryApplicationCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CartesiDAppFactoryApplicationCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CartesiDAppFactoryApplicationCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CartesiDAppFactoryApplicationCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CartesiDAppFactoryApplicationCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CartesiDAppFactoryApplicationCreated represents a ApplicationCreated event raised by the CartesiDAppFactory contract.
+type CartesiDAppFactoryApplicationCreated struct {
+	Consensus    common.Address
+	DappOwner    common.Address
+	TemplateHash [32]byte
+	Application  common.Address
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterApplicationCreated is a free log retrieval operation binding the contract event 0xe73165c2d277daf8713fd08b40845cb6bb7a20b2b543f3d35324a475660fcebd.
+//
+// Solidity: event ApplicationCreated(address indexed consensus, address dappOwner, bytes32 templateHash, address application)
+func (_CartesiDAppFactory *CartesiDAppFactoryFilterer) FilterApplicationCreated(opts *bind.FilterOpts, consensus []common.Address) (*CartesiDAppFactoryApplicationCreatedIterator, error) {
+
+	var consensusRule []interface{}
+	for _, consensusItem := range consensus {
+		consensusRule = append(consensusRule, consensusItem)
+	}
+
+	logs, sub, err := _CartesiDAppFactory.contract.FilterLogs(opts, "ApplicationCreated", consensusRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CartesiDAppFactoryApplicationCreatedIterator{contract: _CartesiDAppFactory.contract, event: "ApplicationCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchApplicationCreated is a free log subscription operation binding the contract event 0xe73165c2d277daf8713fd08b40845cb6bb7a20b2b543f3d35324a475660fcebd.
+//
+// Solidity: event ApplicationCreated(address indexed consensus, address dappOwner, bytes32 templateHash, address application)
+func (_CartesiDAppFactory *CartesiDAppFactoryFilterer) WatchApplicationCreated(opts *bind.WatchOpts, sink chan<- *CartesiDAppFactoryApplicationCreated, consensus []common.Address) (event.Subscription, error) {
+
+	var consensusRule []interface{}
+	for _, consensusItem := range consensus {
+		consensusRule = append(consensusRule, consensusItem)
+	}
+
+	logs, sub, err := _CartesiDAppFactory.contract.WatchLogs(opts, "ApplicationCreated", consensusRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CartesiDAppFactoryApplicationCreated)
+				if err := _CartesiDAppFactory.contract.UnpackLog(event, "ApplicationCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApplicationCreated is a log parse operation binding the contract event 0xe73165c2d277daf8713fd08b40845cb6bb7a20b2b543f3d35324a475660fcebd.
+//
+// Solidity: event ApplicationCreated(address indexed consensus, address dappOwner, bytes32 templateHash, address application)
+func (_CartesiDAppFactory *CartesiDAppFactoryFilterer) ParseApplicationCreated(log types.Log) (*CartesiDAppFactoryApplicationCreated, error) {
+	event := new(CartesiDAppFactoryApplicationCreated)
+	if err := _CartesiDAppFactory.contract.UnpackLog(event, "ApplicationCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
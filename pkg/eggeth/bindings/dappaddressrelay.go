@@ -32,12 +32,34 @@ var (
 // DAppAddressRelayMetaData contains all meta data concerning the DAppAddressRelay contract.
 var DAppAddressRelayMetaData = &bind.MetaData{
 	ABI: "[{\"inputs\":[{\"internalType\":\"contractIInputBox\",\"name\":\"_inputBox\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"getInputBox\",\"outputs\":[{\"internalType\":\"contractIInputBox\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_dapp\",\"type\":\"address\"}],\"name\":\"relayDAppAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60a060405234801561001057600080fd5b506040516102bc3803806102bc83398101604081905261002f91610040565b6001600160a01b0316608052610070565b60006020828403121561005257600080fd5b81516001600160a01b038116811461006957600080fd5b9392505050565b60805161022c61009060003960008181603c015260c8015261022c6000f3fe608060405234801561001057600080fd5b50600436106100355760003560e01c8062aace9a1461003a5780633016f49e14610077575b600080fd5b7f00000000000000000000000000000000000000000000000000000000000000006040516001600160a01b03909116815260200160405180910390f35b61008a61008536600461014f565b61008c565b005b60408051606083901b6bffffffffffffffffffffffff19166020820152815180820360140181526034820192839052631789cd6360e01b9092527f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031690631789cd6390610107908590859060380161017f565b6020604051808303816000875af1158015610126573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061014a91906101dd565b505050565b60006020828403121561016157600080fd5b81356001600160a01b038116811461017857600080fd5b9392505050565b60018060a01b038316815260006020604081840152835180604085015260005b818110156101bb5785810183015185820160600152820161019f565b506000606082860101526060601f19601f830116850101925050509392505050565b6000602082840312156101ef57600080fd5b505191905056fea2646970667358221220c4b6fe1868518e7df0a2dff3d4c6aa4d2f4abf0a04e4ad05a23395005ccaae7664736f6c63430008150033",
 }
 
 // DAppAddressRelayABI is the input ABI used to generate the binding from.
 // Deprecated: Use DAppAddressRelayMetaData.ABI instead.
 var DAppAddressRelayABI = DAppAddressRelayMetaData.ABI
 
+// DAppAddressRelayBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use DAppAddressRelayMetaData.Bin instead.
+var DAppAddressRelayBin = DAppAddressRelayMetaData.Bin
+
+// DeployDAppAddressRelay deploys a new Ethereum contract, binding an instance of DAppAddressRelay to it.
+func DeployDAppAddressRelay(auth *bind.TransactOpts, backend bind.ContractBackend, _inputBox common.Address) (common.Address, *types.Transaction, *DAppAddressRelay, error) {
+	parsed, err := DAppAddressRelayMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(DAppAddressRelayBin), backend, _inputBox)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &DAppAddressRelay{DAppAddressRelayCaller: DAppAddressRelayCaller{contract: contract}, DAppAddressRelayTransactor: DAppAddressRelayTransactor{contract: contract}, DAppAddressRelayFilterer: DAppAddressRelayFilterer{contract: contract}}, nil
+}
+
 // DAppAddressRelay is an auto generated Go binding around an Ethereum contract.
 type DAppAddressRelay struct {
 	DAppAddressRelayCaller     // Read-only binding to the contract
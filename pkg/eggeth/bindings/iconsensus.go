@@ -0,0 +1,396 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IConsensusMetaData contains all meta data concerning the IConsensus contract.
+var IConsensusMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"application\",\"type\":\"address\"}],\"name\":\"ApplicationJoined\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_dapp\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_proofContext\",\"type\":\"bytes\"}],\"name\":\"getClaim\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"epochHash_\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"firstInputIndex_\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lastInputIndex_\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"join\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// IConsensusABI is the input ABI used to generate the binding from.
+// Deprecated: Use IConsensusMetaData.ABI instead.
+var IConsensusABI = IConsensusMetaData.ABI
+
+// IConsensus is an auto generated Go binding around an Ethereum contract.
+type IConsensus struct {
+	IConsensusCaller     // Read-only binding to the contract
+	IConsensusTransactor // Write-only binding to the contract
+	IConsensusFilterer   // Log filterer for contract events
+}
+
+// IConsensusCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IConsensusCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IConsensusTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IConsensusTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IConsensusFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IConsensusFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IConsensusSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IConsensusSession struct {
+	Contract     *IConsensus       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IConsensusCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IConsensusCallerSession struct {
+	Contract *IConsensusCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// IConsensusTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IConsensusTransactorSession struct {
+	Contract     *IConsensusTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// IConsensusRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IConsensusRaw struct {
+	Contract *IConsensus // Generic contract binding to access the raw methods on
+}
+
+// IConsensusCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IConsensusCallerRaw struct {
+	Contract *IConsensusCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IConsensusTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IConsensusTransactorRaw struct {
+	Contract *IConsensusTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIConsensus creates a new instance of IConsensus, bound to a specific deployed contract.
+func NewIConsensus(address common.Address, backend bind.ContractBackend) (*IConsensus, error) {
+	contract, err := bindIConsensus(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IConsensus{IConsensusCaller: IConsensusCaller{contract: contract}, IConsensusTransactor: IConsensusTransactor{contract: contract}, IConsensusFilterer: IConsensusFilterer{contract: contract}}, nil
+}
+
+// NewIConsensusCaller creates a new read-only instance of IConsensus, bound to a specific deployed contract.
+func NewIConsensusCaller(address common.Address, caller bind.ContractCaller) (*IConsensusCaller, error) {
+	contract, err := bindIConsensus(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IConsensusCaller{contract: contract}, nil
+}
+
+// NewIConsensusTransactor creates a new write-only instance of IConsensus, bound to a specific deployed contract.
+func NewIConsensusTransactor(address common.Address, transactor bind.ContractTransactor) (*IConsensusTransactor, error) {
+	contract, err := bindIConsensus(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IConsensusTransactor{contract: contract}, nil
+}
+
+// NewIConsensusFilterer creates a new log filterer instance of IConsensus, bound to a specific deployed contract.
+func NewIConsensusFilterer(address common.Address, filterer bind.ContractFilterer) (*IConsensusFilterer, error) {
+	contract, err := bindIConsensus(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IConsensusFilterer{contract: contract}, nil
+}
+
+// bindIConsensus binds a generic wrapper to an already deployed contract.
+func bindIConsensus(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IConsensusMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IConsensus *IConsensusRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IConsensus.Contract.IConsensusCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IConsensus *IConsensusRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IConsensus.Contract.IConsensusTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IConsensus *IConsensusRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IConsensus.Contract.IConsensusTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IConsensus *IConsensusCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IConsensus.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IConsensus *IConsensusTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IConsensus.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IConsensus *IConsensusTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IConsensus.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetClaim is a free data retrieval call binding the contract method 0xd79a8240.
+//
+// Solidity: function getClaim(address _dapp, bytes _proofContext) view returns(bytes32 epochHash_, uint256 firstInputIndex_, uint256 lastInputIndex_)
+func (_IConsensus *IConsensusCaller) GetClaim(opts *bind.CallOpts, _dapp common.Address, _proofContext []byte) (struct {
+	EpochHash       [32]byte
+	FirstInputIndex *big.Int
+	LastInputIndex  *big.Int
+}, error) {
+	var out []interface{}
+	err := _IConsensus.contract.Call(opts, &out, "getClaim", _dapp, _proofContext)
+
+	outstruct := new(struct {
+		EpochHash       [32]byte
+		FirstInputIndex *big.Int
+		LastInputIndex  *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.EpochHash = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.FirstInputIndex = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.LastInputIndex = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetClaim is a free data retrieval call binding the contract method 0xd79a8240.
+//
+// Solidity: function getClaim(address _dapp, bytes _proofContext) view returns(bytes32 epochHash_, uint256 firstInputIndex_, uint256 lastInputIndex_)
+func (_IConsensus *IConsensusSession) GetClaim(_dapp common.Address, _proofContext []byte) (struct {
+	EpochHash       [32]byte
+	FirstInputIndex *big.Int
+	LastInputIndex  *big.Int
+}, error) {
+	return _IConsensus.Contract.GetClaim(&_IConsensus.CallOpts, _dapp, _proofContext)
+}
+
+// GetClaim is a free data retrieval call binding the contract method 0xd79a8240.
+//
+// Solidity: function getClaim(address _dapp, bytes _proofContext) view returns(bytes32 epochHash_, uint256 firstInputIndex_, uint256 lastInputIndex_)
+func (_IConsensus *IConsensusCallerSession) GetClaim(_dapp common.Address, _proofContext []byte) (struct {
+	EpochHash       [32]byte
+	FirstInputIndex *big.Int
+	LastInputIndex  *big.Int
+}, error) {
+	return _IConsensus.Contract.GetClaim(&_IConsensus.CallOpts, _dapp, _proofContext)
+}
+
+// Join is a paid mutator transaction binding the contract method 0xb688a363.
+//
+// Solidity: function join() returns()
+func (_IConsensus *IConsensusTransactor) Join(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IConsensus.contract.Transact(opts, "join")
+}
+
+// Join is a paid mutator transaction binding the contract method 0xb688a363.
+//
+// Solidity: function join() returns()
+func (_IConsensus *IConsensusSession) Join() (*types.Transaction, error) {
+	return _IConsensus.Contract.Join(&_IConsensus.TransactOpts)
+}
+
+// Join is a paid mutator transaction binding the contract method 0xb688a363.
+//
+// Solidity: function join() returns()
+func (_IConsensus *IConsensusTransactorSession) Join() (*types.Transaction, error) {
+	return _IConsensus.Contract.Join(&_IConsensus.TransactOpts)
+}
+
+// IConsensusApplicationJoinedIterator is returned from FilterApplicationJoined and is used to iterate over the raw logs and unpacked data for ApplicationJoined events raised by the IConsensus contract.
+type IConsensusApplicationJoinedIterator struct {
+	Event *IConsensusApplicationJoined // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IConsensusApplicationJoinedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IConsensusApplicationJoined)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IConsensusApplicationJoined)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IConsensusApplicationJoinedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IConsensusApplicationJoinedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IConsensusApplicationJoined represents a ApplicationJoined event raised by the IConsensus contract.
+type IConsensusApplicationJoined struct {
+	Application common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterApplicationJoined is a free log retrieval operation binding the contract event 0x27c2b702d3bff195a18baca2daf00b20a986177c5f1449af4e2d46a3c3e02ce5.
+//
+// Solidity: event ApplicationJoined(address indexed application)
+func (_IConsensus *IConsensusFilterer) FilterApplicationJoined(opts *bind.FilterOpts, application []common.Address) (*IConsensusApplicationJoinedIterator, error) {
+
+	var applicationRule []interface{}
+	for _, applicationItem := range application {
+		applicationRule = append(applicationRule, applicationItem)
+	}
+
+	logs, sub, err := _IConsensus.contract.FilterLogs(opts, "ApplicationJoined", applicationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IConsensusApplicationJoinedIterator{contract: _IConsensus.contract, event: "ApplicationJoined", logs: logs, sub: sub}, nil
+}
+
+// WatchApplicationJoined is a free log subscription operation binding the contract event 0x27c2b702d3bff195a18baca2daf00b20a986177c5f1449af4e2d46a3c3e02ce5.
+//
+// Solidity: event ApplicationJoined(address indexed application)
+func (_IConsensus *IConsensusFilterer) WatchApplicationJoined(opts *bind.WatchOpts, sink chan<- *IConsensusApplicationJoined, application []common.Address) (event.Subscription, error) {
+
+	var applicationRule []interface{}
+	for _, applicationItem := range application {
+		applicationRule = append(applicationRule, applicationItem)
+	}
+
+	logs, sub, err := _IConsensus.contract.WatchLogs(opts, "ApplicationJoined", applicationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IConsensusApplicationJoined)
+				if err := _IConsensus.contract.UnpackLog(event, "ApplicationJoined", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApplicationJoined is a log parse operation binding the contract event 0x27c2b702d3bff195a18baca2daf00b20a986177c5f1449af4e2d46a3c3e02ce5.
+//
+// Solidity: event ApplicationJoined(address indexed application)
+func (_IConsensus *IConsensusFilterer) ParseApplicationJoined(log types.Log) (*IConsensusApplicationJoined, error) {
+	event := new(IConsensusApplicationJoined)
+	if err := _IConsensus.contract.UnpackLog(event, "ApplicationJoined", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
 )
 
@@ -19,11 +21,14 @@ import (
 // This struct provides methods that are specific for the Cartesi Rollups.
 type ETHClient struct {
 
-	// Gas limit when building transactions.
-	GasLimit uint64
+	// Default options when sending transactions; used when the opts argument is nil.
+	TxOptions TxOptions
 
 	client              *ethclient.Client
+	deployment          Deployment
+	nonces              *nonceManager
 	dappAddress         common.Address
+	dapp                *bindings.CartesiDApp
 	dappAddressRelay    *bindings.DAppAddressRelay
 	erc1155BatchPortal  *bindings.ERC1155BatchPortal
 	erc1155SinglePortal *bindings.ERC1155SinglePortal
@@ -33,44 +38,72 @@ type ETHClient struct {
 	inputBox            *bindings.InputBox
 }
 
-// Create new ETH client.
+// Create new ETH client for the contracts deployed in localhost.
 func NewETHClient(endpoint string, dappAddress common.Address) (*ETHClient, error) {
+	return NewETHClientWithDeployment(endpoint, dappAddress, LocalhostDeployment())
+}
+
+// Create new ETH client for the contracts in the given deployment.
+func NewETHClientWithDeployment(
+	endpoint string, dappAddress common.Address, deployment Deployment) (*ETHClient, error) {
+
 	client, err := ethclient.Dial(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to Ethereum: %v", err)
 	}
-	dappAddressRelay, err := bindings.NewDAppAddressRelay(AddressDAppAddressRelay, client)
+	return newETHClient(client, dappAddress, deployment)
+}
+
+// Create new ETH client for the contracts in the given deployment, using an existing RPC
+// client, such as an in-process client.
+func NewETHClientWithRPC(
+	client *rpc.Client, dappAddress common.Address, deployment Deployment) (*ETHClient, error) {
+	return newETHClient(ethclient.NewClient(client), dappAddress, deployment)
+}
+
+// Create new ETH client with the given go-ethereum client.
+func newETHClient(
+	client *ethclient.Client, dappAddress common.Address, deployment Deployment) (*ETHClient, error) {
+
+	dapp, err := bindings.NewCartesiDApp(dappAddress, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to CartesiDApp contract: %v", err)
+	}
+	dappAddressRelay, err := bindings.NewDAppAddressRelay(deployment.DAppAddressRelay, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to DAppAddressRelaya contract: %v", err)
 	}
-	erc1155BatchPortal, err := bindings.NewERC1155BatchPortal(AddressERC1155BatchPortal, client)
+	erc1155BatchPortal, err := bindings.NewERC1155BatchPortal(deployment.ERC1155BatchPortal, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to ERC1155BatchPortal contract: %v", err)
 	}
-	erc1155SinglePortal, err := bindings.NewERC1155SinglePortal(AddressERC1155SinglePortal, client)
+	erc1155SinglePortal, err := bindings.NewERC1155SinglePortal(deployment.ERC1155SinglePortal, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to ERC1155SinglePortal contract: %v", err)
 	}
-	erc20Portal, err := bindings.NewERC20Portal(AddressERC20Portal, client)
+	erc20Portal, err := bindings.NewERC20Portal(deployment.ERC20Portal, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to ERC20Portal contract: %v", err)
 	}
-	erc721Portal, err := bindings.NewERC721Portal(AddressERC721Portal, client)
+	erc721Portal, err := bindings.NewERC721Portal(deployment.ERC721Portal, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to ERC721Portal contract: %v", err)
 	}
-	etherPortal, err := bindings.NewEtherPortal(AddressEtherPortal, client)
+	etherPortal, err := bindings.NewEtherPortal(deployment.EtherPortal, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to EtherPortal contract: %v", err)
 	}
-	inputBox, err := bindings.NewInputBox(AddressInputBox, client)
+	inputBox, err := bindings.NewInputBox(deployment.InputBox, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to InputBox contract: %v", err)
 	}
 	ethClient := &ETHClient{
-		GasLimit:            DefaultGasLimit,
+		TxOptions:           MakeTxOptions(),
 		client:              client,
+		deployment:          deployment,
+		nonces:              newNonceManager(),
 		dappAddress:         dappAddress,
+		dapp:                dapp,
 		dappAddressRelay:    dappAddressRelay,
 		erc1155BatchPortal:  erc1155BatchPortal,
 		erc1155SinglePortal: erc1155SinglePortal,
@@ -82,6 +115,11 @@ func NewETHClient(endpoint string, dappAddress common.Address) (*ETHClient, erro
 	return ethClient, nil
 }
 
+// Get the deployment of the Cartesi Rollups contracts used by the client.
+func (c *ETHClient) Deployment() Deployment {
+	return c.deployment
+}
+
 // Get the chain ID.
 func (c *ETHClient) ChainID(ctx context.Context) (*big.Int, error) {
 	return c.client.ChainID(ctx)
@@ -89,32 +127,159 @@ func (c *ETHClient) ChainID(ctx context.Context) (*big.Int, error) {
 
 // Send the input to the DApp contract.
 // This function waits until the transaction is added to a block and return the input index.
-func (c *ETHClient) SendInput(ctx context.Context, signer Signer, input []byte) (int, error) {
-	receipt, err := sendTransaction(
-		ctx, c.client, signer, big.NewInt(0), c.GasLimit,
+func (c *ETHClient) SendInput(
+	ctx context.Context,
+	signer Signer,
+	input []byte,
+	opts *TxOptions,
+) (int, error) {
+	return c.sendInput(
+		ctx, signer, big.NewInt(0), c.txOptions(opts),
 		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
 			return c.inputBox.AddInput(txOpts, c.dappAddress, input)
 		},
 	)
+}
+
+// Send the inputs to the DApp contract.
+// This function submits the transactions back-to-back and then waits for their receipts
+// concurrently. It returns the input indices in the same order of the given inputs.
+// If a transaction is dropped, this function sends its input again, so the
+// indices may not be sequential.
+func (c *ETHClient) SendInputs(
+	ctx context.Context,
+	signer Signer,
+	inputs [][]byte,
+	opts *TxOptions,
+) ([]int, error) {
+	txOpts := c.txOptions(opts)
+	submit := func(input []byte) (*types.Transaction, error) {
+		return _submitTransaction(
+			ctx, c.client, c.nonces, signer, big.NewInt(0), txOpts,
+			func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+				return c.inputBox.AddInput(txOpts, c.dappAddress, input)
+			},
+		)
+	}
+
+	txs := make([]*types.Transaction, len(inputs))
+	for i, input := range inputs {
+		tx, err := submit(input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send input %v: %v", i, err)
+		}
+		txs[i] = tx
+	}
+
+	// Limit the number of concurrent requests to the node.
+	const maxConcurrentWaits = 32
+	semaphore := make(chan struct{}, maxConcurrentWaits)
+	indices := make([]int, len(inputs))
+	errs := make([]error, len(inputs))
+	var wg sync.WaitGroup
+	for i := range inputs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			resubmit := func() (*types.Transaction, error) {
+				return submit(inputs[i])
+			}
+			indices[i], errs[i] = c.waitForInput(ctx, signer, txs[i], txOpts, resubmit)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("failed to send input %v: %v", i, err)
+		}
+	}
+	return indices, nil
+}
+
+// Send the transaction that adds an input and return the input index.
+func (c *ETHClient) sendInput(
+	ctx context.Context,
+	signer Signer,
+	txValue *big.Int,
+	opts TxOptions,
+	doSend func(txOpts *bind.TransactOpts) (*types.Transaction, error),
+) (int, error) {
+	submit := func() (*types.Transaction, error) {
+		return _submitTransaction(ctx, c.client, c.nonces, signer, txValue, opts, doSend)
+	}
+	tx, err := submit()
 	if err != nil {
 		return 0, err
 	}
-	return c.getInputIndex(ctx, receipt)
+	return c.waitForInput(ctx, signer, tx, opts, submit)
+}
+
+// Wait for the input transaction and return the input index.
+// If the transaction is dropped, send it again with resubmit.
+// If the options require confirmations and a reorg changes the input index while
+// waiting for them, return an InputReorgError with the new index.
+func (c *ETHClient) waitForInput(
+	ctx context.Context,
+	signer Signer,
+	tx *types.Transaction,
+	opts TxOptions,
+	resubmit func() (*types.Transaction, error),
+) (int, error) {
+	for attempt := 1; ; attempt++ {
+		receipt, err := _waitForTransaction(ctx, c.client, tx, opts.TraceOnRevert)
+		var inputIndex int
+		if err == nil {
+			inputIndex, err = c.getInputIndex(ctx, receipt)
+		}
+		if err == nil && opts.Confirmations > 0 {
+			receipt, err = _waitForConfirmations(
+				ctx, c.client, tx, receipt, opts.Confirmations, opts.TraceOnRevert)
+		}
+		if _, ok := err.(transactionDropped); ok && attempt < maxSendAttempts {
+			c.nonces.reset(signer.Account())
+			tx, err = resubmit()
+			if err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if opts.Confirmations == 0 {
+			return inputIndex, nil
+		}
+		confirmedIndex, err := c.getInputIndex(ctx, receipt)
+		if err != nil {
+			return 0, err
+		}
+		if confirmedIndex != inputIndex {
+			return 0, InputReorgError{
+				TxHash:   tx.Hash(),
+				Index:    inputIndex,
+				NewIndex: confirmedIndex,
+			}
+		}
+		return confirmedIndex, nil
+	}
 }
 
 // Send the DApp address to the DApp contract with the DAppAddressRelay contract.
 // This function waits until the transaction is added to a block and return the input index.
-func (c *ETHClient) SendDAppAddress(ctx context.Context, signer Signer) (int, error) {
-	receipt, err := sendTransaction(
-		ctx, c.client, signer, big.NewInt(0), c.GasLimit,
+func (c *ETHClient) SendDAppAddress(
+	ctx context.Context,
+	signer Signer,
+	opts *TxOptions,
+) (int, error) {
+	return c.sendInput(
+		ctx, signer, big.NewInt(0), c.txOptions(opts),
 		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
 			return c.dappAddressRelay.RelayDAppAddress(txOpts, c.dappAddress)
 		},
 	)
-	if err != nil {
-		return 0, err
-	}
-	return c.getInputIndex(ctx, receipt)
 }
 
 // Send Ether to the Ether portal. This function also receives an optional input.
@@ -124,17 +289,14 @@ func (c *ETHClient) SendEther(
 	signer Signer,
 	txValue *big.Int,
 	input []byte,
+	opts *TxOptions,
 ) (int, error) {
-	receipt, err := sendTransaction(
-		ctx, c.client, signer, txValue, c.GasLimit,
+	return c.sendInput(
+		ctx, signer, txValue, c.txOptions(opts),
 		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
 			return c.etherPortal.DepositEther(txOpts, c.dappAddress, input)
 		},
 	)
-	if err != nil {
-		return 0, err
-	}
-	return c.getInputIndex(ctx, receipt)
 }
 
 // Send an ERC20 token to the ERC20 portal. This function also receives an optional input.
@@ -146,12 +308,13 @@ func (c *ETHClient) SendERC20Tokens(
 	token common.Address,
 	amount *big.Int,
 	input []byte,
+	opts *TxOptions,
 ) (int, error) {
 	erc20, err := bindings.NewIERC20(token, c.client)
 	if err != nil {
 		return 0, fmt.Errorf("failed to connect to ERC20 token: %v", err)
 	}
-	currAllowance, err := erc20.Allowance(nil, signer.Account(), AddressERC20Portal)
+	currAllowance, err := erc20.Allowance(nil, signer.Account(), c.deployment.ERC20Portal)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get allowance: %v", err)
 	}
@@ -159,107 +322,283 @@ func (c *ETHClient) SendERC20Tokens(
 		// Approve remaining allowance to reach requested amount
 		remainingAllowance := new(big.Int).Sub(amount, currAllowance)
 		_, err := sendTransaction(
-			ctx, c.client, signer, big.NewInt(0), c.GasLimit,
+			ctx, c.client, c.nonces, signer, big.NewInt(0), c.txOptions(opts),
 			func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
-				return erc20.Approve(txOpts, AddressERC20Portal, remainingAllowance)
+				return erc20.Approve(txOpts, c.deployment.ERC20Portal, remainingAllowance)
 			},
 		)
 		if err != nil {
 			return 0, fmt.Errorf("failed to approve allowance: %v", err)
 		}
 	}
-	receipt, err := sendTransaction(
-		ctx, c.client, signer, big.NewInt(0), c.GasLimit,
+	return c.sendInput(
+		ctx, signer, big.NewInt(0), c.txOptions(opts),
 		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
 			return c.erc20Portal.DepositERC20Tokens(
 				txOpts, token, c.dappAddress, amount, input)
 		},
 	)
+}
+
+// Send an ERC721 token to the ERC721 portal. This function also receives an optional input.
+// This function approves the portal to transfer the token before sending it, if necessary.
+// This function waits until the transaction is added to a block and return the input index.
+func (c *ETHClient) SendERC721Token(
+	ctx context.Context,
+	signer Signer,
+	token common.Address,
+	tokenId *big.Int,
+	baseLayerData []byte,
+	input []byte,
+	opts *TxOptions,
+) (int, error) {
+	erc721, err := bindings.NewIERC721(token, c.client)
+	if err != nil {
+		return 0, fmt.Errorf("failed to connect to ERC721 token: %v", err)
+	}
+	approved, err := erc721.GetApproved(nil, tokenId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get approved: %v", err)
+	}
+	approvedForAll, err := erc721.IsApprovedForAll(nil, signer.Account(), c.deployment.ERC721Portal)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get approved for all: %v", err)
+	}
+	if approved != c.deployment.ERC721Portal && !approvedForAll {
+		_, err := sendTransaction(
+			ctx, c.client, c.nonces, signer, big.NewInt(0), c.txOptions(opts),
+			func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+				return erc721.Approve(txOpts, c.deployment.ERC721Portal, tokenId)
+			},
+		)
+		if err != nil {
+			return 0, fmt.Errorf("failed to approve token: %v", err)
+		}
+	}
+	return c.sendInput(
+		ctx, signer, big.NewInt(0), c.txOptions(opts),
+		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.erc721Portal.DepositERC721Token(
+				txOpts, token, c.dappAddress, tokenId, baseLayerData, input)
+		},
+	)
+}
+
+// Send an ERC1155 token to the ERC1155 single portal. This function also receives an optional
+// input. This function approves the portal to transfer the tokens before sending them, if
+// necessary. This function waits until the transaction is added to a block and return the input
+// index.
+func (c *ETHClient) SendERC1155Single(
+	ctx context.Context,
+	signer Signer,
+	token common.Address,
+	tokenId *big.Int,
+	value *big.Int,
+	baseLayerData []byte,
+	input []byte,
+	opts *TxOptions,
+) (int, error) {
+	err := c.approveERC1155(ctx, signer, token, c.deployment.ERC1155SinglePortal, opts)
+	if err != nil {
+		return 0, err
+	}
+	return c.sendInput(
+		ctx, signer, big.NewInt(0), c.txOptions(opts),
+		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.erc1155SinglePortal.DepositSingleERC1155Token(
+				txOpts, token, c.dappAddress, tokenId, value, baseLayerData, input)
+		},
+	)
+}
+
+// Send ERC1155 tokens to the ERC1155 batch portal. This function also receives an optional
+// input. This function approves the portal to transfer the tokens before sending them, if
+// necessary. This function waits until the transaction is added to a block and return the input
+// index.
+func (c *ETHClient) SendERC1155Batch(
+	ctx context.Context,
+	signer Signer,
+	token common.Address,
+	tokenIds []*big.Int,
+	values []*big.Int,
+	baseLayerData []byte,
+	input []byte,
+	opts *TxOptions,
+) (int, error) {
+	// Basic sanity check before sending the transaction.
+	if len(tokenIds) == 0 {
+		return 0, fmt.Errorf("no token ids")
+	}
+	if len(tokenIds) != len(values) {
+		return 0, fmt.Errorf("tokenIds and values mismatch")
+	}
+	err := c.approveERC1155(ctx, signer, token, c.deployment.ERC1155BatchPortal, opts)
 	if err != nil {
 		return 0, err
 	}
-	return c.getInputIndex(ctx, receipt)
+	return c.sendInput(
+		ctx, signer, big.NewInt(0), c.txOptions(opts),
+		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.erc1155BatchPortal.DepositBatchERC1155Token(
+				txOpts, token, c.dappAddress, tokenIds, values, baseLayerData, input)
+		},
+	)
 }
 
-// Send assets to the ERC721 portal.
-// func (c *ETHClient) SendERC721Token(
-// 	ctx context.Context,
-// 	signer Signer,
-// 	token common.Address,
-// 	tokenId *big.Int,
-// 	baseLayerData []byte,
-// 	input []byte,
-// ) (int, error) {
-// 	receipt, err := sendTransaction(
-// 		ctx, c.client, signer, big.NewInt(0), c.GasLimit,
-// 		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
-// 			return c.erc721Portal.DepositERC721Token(
-// 				txOpts, token, c.dappAddress, tokenId, baseLayerData, input)
-// 		},
-// 	)
-// 	if err != nil {
-// 		return 0, err
-// 	}
-// 	return c.getInputIndex(ctx, receipt)
-// }
-
-// Send assets ot the ERC1155 single portal.
-// func (c *ETHClient) SendSingleERC1155Token(
-// 	ctx context.Context,
-// 	signer Signer,
-// 	token common.Address,
-// 	tokenId *big.Int,
-// 	value *big.Int,
-// 	baseLayerData []byte,
-// 	input []byte,
-// ) (int, error) {
-// 	receipt, err := sendTransaction(
-// 		ctx, c.client, signer, big.NewInt(0), c.GasLimit,
-// 		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
-// 			return c.erc1155SinglePortal.DepositSingleERC1155Token(
-// 				txOpts, token, c.dappAddress, tokenId, value, baseLayerData, input)
-// 		},
-// 	)
-// 	if err != nil {
-// 		return 0, err
-// 	}
-// 	return c.getInputIndex(ctx, receipt)
-// }
-
-// Send assets ot the ERC1155 batch portal.
-// func (c *ETHClient) SendBatchERC1155Tokens(
-// 	ctx context.Context,
-// 	signer Signer,
-// 	token common.Address,
-// 	tokenIds []*big.Int,
-// 	values []*big.Int,
-// 	baseLayerData []byte,
-// 	input []byte,
-// ) (int, error) {
-// 	// Basic sanity check before sending the transaction.
-// 	if len(tokenIds) == 0 {
-// 		return 0, fmt.Errorf("no token ids")
-// 	}
-// 	if len(tokenIds) != len(values) {
-// 		return 0, fmt.Errorf("tokenIds and values mismatch")
-// 	}
-// 	receipt, err := sendTransaction(
-// 		ctx, c.client, signer, big.NewInt(0), c.GasLimit,
-// 		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
-// 			return c.erc1155BatchPortal.DepositBatchERC1155Token(
-// 				txOpts, token, c.dappAddress, tokenIds, values, baseLayerData, input)
-// 		},
-// 	)
-// 	if err != nil {
-// 		return 0, err
-// 	}
-// 	return c.getInputIndex(ctx, receipt)
-// }
+// Approve the portal to transfer the signer's ERC1155 tokens, if necessary.
+func (c *ETHClient) approveERC1155(
+	ctx context.Context,
+	signer Signer,
+	token common.Address,
+	portal common.Address,
+	opts *TxOptions,
+) error {
+	erc1155, err := bindings.NewIERC1155(token, c.client)
+	if err != nil {
+		return fmt.Errorf("failed to connect to ERC1155 token: %v", err)
+	}
+	approved, err := erc1155.IsApprovedForAll(nil, signer.Account(), portal)
+	if err != nil {
+		return fmt.Errorf("failed to get approved for all: %v", err)
+	}
+	if approved {
+		return nil
+	}
+	_, err = sendTransaction(
+		ctx, c.client, c.nonces, signer, big.NewInt(0), c.txOptions(opts),
+		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc1155.SetApprovalForAll(txOpts, portal, true)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to approve tokens: %v", err)
+	}
+	return nil
+}
+
+// Execute the voucher in the DApp contract using the given proof.
+// This function waits until the transaction is added to a block.
+func (c *ETHClient) ExecuteVoucher(
+	ctx context.Context,
+	signer Signer,
+	destination common.Address,
+	payload []byte,
+	proof *bindings.Proof,
+	opts *TxOptions,
+) error {
+	_, err := sendTransaction(
+		ctx, c.client, c.nonces, signer, big.NewInt(0), c.txOptions(opts),
+		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.dapp.ExecuteVoucher(txOpts, destination, payload, *proof)
+		},
+	)
+	return err
+}
+
+// Check whether the voucher was already executed in the DApp contract.
+func (c *ETHClient) VoucherExecuted(
+	ctx context.Context, inputIndex int, outputIndex int) (bool, error) {
+
+	opts := &bind.CallOpts{Context: ctx}
+	executed, err := c.dapp.WasVoucherExecuted(
+		opts, big.NewInt(int64(inputIndex)), big.NewInt(int64(outputIndex)))
+	if err != nil {
+		return false, fmt.Errorf("failed to check voucher: %v", err)
+	}
+	return executed, nil
+}
+
+// Validate the notice in the DApp contract using the given proof.
+// Return error if the DApp contract rejects the proof.
+func (c *ETHClient) ValidateNotice(
+	ctx context.Context, payload []byte, proof *bindings.Proof) error {
+
+	opts := &bind.CallOpts{Context: ctx}
+	valid, err := c.dapp.ValidateNotice(opts, payload, *proof)
+	if err != nil {
+		return fmt.Errorf("failed to validate notice: %v", err)
+	}
+	if !valid {
+		return fmt.Errorf("invalid notice")
+	}
+	return nil
+}
+
+// Check whether the epoch of the given input is closed.
+// The epoch is closed when the DApp consensus has a claim that includes the input.
+func (c *ETHClient) EpochClosed(ctx context.Context, inputIndex int) (bool, error) {
+	consensusAddress, err := c.Consensus(ctx)
+	if err != nil {
+		return false, err
+	}
+	consensus, err := bindings.NewIConsensus(consensusAddress, c.client)
+	if err != nil {
+		return false, fmt.Errorf("failed to connect to consensus contract: %v", err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	// Check whether the claim exists; the history reverts with InvalidClaimIndex for
+	// claim indices it doesn't have.
+	getClaim := func(claimIndex int64) (*big.Int, bool, error) {
+		proofContext := common.BigToHash(big.NewInt(claimIndex)).Bytes()
+		claim, err := consensus.GetClaim(opts, c.dappAddress, proofContext)
+		if err != nil {
+			if data, ok := revertData(err); ok {
+				revertErr := decodeRevert(common.Hash{}, data)
+				if revertErr.Name == "InvalidClaimIndex" {
+					return nil, false, nil
+				}
+				return nil, false, revertErr
+			}
+			return nil, false, fmt.Errorf("failed to get claim: %v", err)
+		}
+		return claim.LastInputIndex, true, nil
+	}
+
+	// Find the last claim with an exponential search followed by a binary search.
+	lastInputIndex, found, err := getClaim(0)
+	if err != nil || !found {
+		return false, err
+	}
+	low, high := int64(0), int64(1)
+	for {
+		claimLastInputIndex, found, err := getClaim(high)
+		if err != nil {
+			return false, err
+		}
+		if !found {
+			break
+		}
+		low, lastInputIndex = high, claimLastInputIndex
+		high *= 2
+	}
+	for high-low > 1 {
+		middle := low + (high-low)/2
+		claimLastInputIndex, found, err := getClaim(middle)
+		if err != nil {
+			return false, err
+		}
+		if found {
+			low, lastInputIndex = middle, claimLastInputIndex
+		} else {
+			high = middle
+		}
+	}
+	return lastInputIndex.Cmp(big.NewInt(int64(inputIndex))) >= 0, nil
+}
+
+// Return the given transaction options or the default ones if nil.
+func (c *ETHClient) txOptions(opts *TxOptions) TxOptions {
+	if opts == nil {
+		return c.TxOptions
+	}
+	return *opts
+}
 
 // Get input index in the transaction by looking at the event logs.
 func (c *ETHClient) getInputIndex(ctx context.Context, receipt *types.Receipt) (int, error) {
 	for _, log := range receipt.Logs {
-		if log.Address != AddressInputBox {
+		if log.Address != c.deployment.InputBox {
 			continue
 		}
 		inputAdded, err := c.inputBox.ParseInputAdded(*log)
@@ -272,3 +611,16 @@ func (c *ETHClient) getInputIndex(ctx context.Context, receipt *types.Receipt) (
 	}
 	return 0, fmt.Errorf("input index not found")
 }
+
+// Error returned when a reorg changes the index of an input while waiting for the
+// confirmations of its transaction.
+type InputReorgError struct {
+	TxHash   common.Hash
+	Index    int
+	NewIndex int
+}
+
+func (e InputReorgError) Error() string {
+	return fmt.Sprintf("input index of transaction %v changed from %v to %v after a reorg",
+		e.TxHash, e.Index, e.NewIndex)
+}
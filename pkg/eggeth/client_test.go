@@ -6,13 +6,18 @@ package eggeth
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -71,7 +76,7 @@ func TestClient(t *testing.T) {
 		{
 			name: "SendInput",
 			do: func(ctx context.Context, c *ETHClient, s Signer) (int, error) {
-				return c.SendInput(ctx, s, common.Hex2Bytes("deadbeef"))
+				return c.SendInput(ctx, s, common.Hex2Bytes("deadbeef"), nil)
 			},
 			sender: common.HexToAddress("f39fd6e51aad88f6f4ce6ab8827279cfffb92266"),
 			input:  common.Hex2Bytes("deadbeef"),
@@ -79,17 +84,17 @@ func TestClient(t *testing.T) {
 		{
 			name: "SendDAppAddress",
 			do: func(ctx context.Context, c *ETHClient, s Signer) (int, error) {
-				return c.SendDAppAddress(ctx, s)
+				return c.SendDAppAddress(ctx, s, nil)
 			},
-			sender: AddressDAppAddressRelay,
+			sender: client.Deployment().DAppAddressRelay,
 			input:  client.dappAddress[:],
 		},
 		{
 			name: "SendEther",
 			do: func(ctx context.Context, c *ETHClient, s Signer) (int, error) {
-				return c.SendEther(ctx, s, big.NewInt(65535), common.Hex2Bytes("deadbeef"))
+				return c.SendEther(ctx, s, big.NewInt(65535), common.Hex2Bytes("deadbeef"), nil)
 			},
-			sender: AddressEtherPortal,
+			sender: client.Deployment().EtherPortal,
 			input: common.Hex2Bytes("" +
 				// sender address
 				"f39fd6e51aad88f6f4ce6ab8827279cfffb92266" +
@@ -104,9 +109,9 @@ func TestClient(t *testing.T) {
 			do: func(ctx context.Context, c *ETHClient, s Signer) (int, error) {
 				amount := big.NewInt(65535)
 				input := common.Hex2Bytes("deadbeef")
-				return c.SendERC20Tokens(ctx, s, erc20Token, amount, input)
+				return c.SendERC20Tokens(ctx, s, erc20Token, amount, input, nil)
 			},
-			sender: AddressERC20Portal,
+			sender: client.Deployment().ERC20Portal,
 			input: common.Hex2Bytes("" +
 				// success
 				"01" +
@@ -142,6 +147,47 @@ func TestClient(t *testing.T) {
 			t.Fatalf("wrong input: %x", readInput)
 		}
 	}
+
+	t.Logf("testing client.SendInputs")
+	inputs := [][]byte{{0}, {1}, {2}, {3}, {4}}
+	indices, err := client.SendInputs(ctx, signer, inputs, nil)
+	if err != nil {
+		logContainerOutput(t, ctx, anvilContainer)
+		t.Fatalf("failed to send inputs: %v", err)
+	}
+	for i, inputIndex := range indices {
+		if inputIndex != len(testCases)+i {
+			t.Fatalf("wrong input index: %v; expected: %v", inputIndex, len(testCases)+i)
+		}
+		_, readInput, err := getInput(client.inputBox, client.dappAddress, inputIndex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(readInput, inputs[i]) {
+			t.Fatalf("wrong input: %x", readInput)
+		}
+	}
+
+	t.Logf("testing client.DeployDApp")
+	consensus := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	templateHash := common.HexToHash("0xdeadbeef")
+	salt := common.HexToHash("0x01")
+	newDApp, err := client.DeployDApp(ctx, signer, consensus, common.Address{}, templateHash, salt, nil)
+	if err != nil {
+		logContainerOutput(t, ctx, anvilContainer)
+		t.Fatalf("failed to deploy dapp: %v", err)
+	}
+	newClient, err := NewETHClient(endpoint, newDApp)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	newConsensus, err := newClient.Consensus(ctx)
+	if err != nil {
+		t.Fatalf("failed to get consensus: %v", err)
+	}
+	if newConsensus != consensus {
+		t.Fatalf("wrong consensus: %v", newConsensus)
+	}
 }
 
 // We use the sunodo devnet docker image to test the client.
@@ -201,3 +247,124 @@ func logContainerOutput(t *testing.T, ctx context.Context, container testcontain
 	}
 	t.Log(string(bytes))
 }
+
+func TestWaitForInputReorg(t *testing.T) {
+	confirmationPollInterval = 10 * time.Millisecond
+	dapp := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	signer, _ := NewPrivateKeySignerFromHex(testPrivateKey, big.NewInt(31337))
+	key, _ := crypto.HexToECDSA(testPrivateKey)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(31337)),
+		&types.LegacyTx{GasPrice: big.NewInt(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the input moves from index 0 to index 1 after the first receipt
+	receipt := makeStubReceipt(t, tx,
+		makeInputAddedLog(t, dapp, 0, signer.Account(), []byte("first"), 1))
+	receipt.BlockHash = common.HexToHash("0x01")
+	reorgedReceipt := makeStubReceipt(t, tx,
+		makeInputAddedLog(t, dapp, 1, signer.Account(), []byte("first"), 1))
+	receipts := []*types.Receipt{receipt, reorgedReceipt}
+	txFields := makeStubTransaction(t, tx, receipt)
+	ethClient := setupStubNode(t, map[string]any{
+		"eth_blockNumber":          "0x3",
+		"eth_getBlockByNumber":     makeStubHeader(big.NewInt(100)),
+		"eth_getTransactionByHash": txFields,
+		"eth_getTransactionReceipt": func() any {
+			r := receipts[0]
+			if len(receipts) > 1 {
+				receipts = receipts[1:]
+			}
+			return r
+		},
+	})
+	client, err := newETHClient(ethClient, dapp, LocalhostDeployment())
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := MakeTxOptions()
+	opts.Confirmations = 2
+	_, err = client.waitForInput(context.Background(), signer, tx, opts, nil)
+	expectedErr := InputReorgError{TxHash: tx.Hash(), Index: 0, NewIndex: 1}
+	if err != expectedErr {
+		t.Fatalf("wrong error: %v", err)
+	}
+}
+
+func TestEpochClosed(t *testing.T) {
+	dapp := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	consensus := common.HexToAddress("0xc0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0c0")
+	dappABI, _ := bindings.CartesiDAppMetaData.GetAbi()
+	consensusABI, _ := bindings.IConsensusMetaData.GetAbi()
+	historyABI, _ := bindings.HistoryMetaData.GetAbi()
+	invalidClaimIndex := historyABI.Errors["InvalidClaimIndex"].ID.Bytes()[:4]
+
+	// the consensus has 5 claims and the claim i contains the inputs from 10*i to 10*i+9
+	const numClaims = 5
+	var otherRevert atomic.Bool
+	ethClient := setupStubNode(t, map[string]any{
+		"eth_call": func(params []json.RawMessage) any {
+			var call struct {
+				Input hexutil.Bytes `json:"input"`
+			}
+			if err := json.Unmarshal(params[0], &call); err != nil {
+				return err
+			}
+			method, err := dappABI.MethodById(call.Input)
+			if err == nil && method.Name == "getConsensus" {
+				data, _ := method.Outputs.Pack(consensus)
+				return hexutil.Encode(data)
+			}
+			method, err = consensusABI.MethodById(call.Input)
+			if err != nil {
+				return err
+			}
+			args, err := method.Inputs.Unpack(call.Input[4:])
+			if err != nil {
+				return err
+			}
+			claimIndex := new(big.Int).SetBytes(args[1].([]byte)).Int64()
+			if otherRevert.Load() {
+				return stubRevert{[]byte{0xde, 0xad, 0xbe, 0xef}}
+			}
+			if claimIndex >= numClaims {
+				return stubRevert{invalidClaimIndex}
+			}
+			data, _ := method.Outputs.Pack(common.Hash{},
+				big.NewInt(10*claimIndex), big.NewInt(10*claimIndex+9))
+			return hexutil.Encode(data)
+		},
+	})
+	client, err := newETHClient(ethClient, dapp, LocalhostDeployment())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, testCase := range []struct {
+		inputIndex int
+		closed     bool
+	}{
+		{0, true},
+		{49, true},
+		{50, false},
+	} {
+		closed, err := client.EpochClosed(context.Background(), testCase.inputIndex)
+		if err != nil {
+			t.Fatalf("failed to check epoch: %v", err)
+		}
+		if closed != testCase.closed {
+			t.Fatalf("wrong result for input %v: %v", testCase.inputIndex, closed)
+		}
+	}
+
+	// other reverts are not mistaken for a missing claim
+	otherRevert.Store(true)
+	_, err = client.EpochClosed(context.Background(), 0)
+	revertErr, ok := err.(*RevertError)
+	if !ok {
+		t.Fatalf("expected revert error; got %v", err)
+	}
+	if revertErr.Selector != [4]byte{0xde, 0xad, 0xbe, 0xef} {
+		t.Fatalf("wrong selector: %x", revertErr.Selector)
+	}
+}
@@ -17,27 +17,65 @@ package eggeth
 //go:generate sh -c "jq .abi < contracts/out/IERC20.sol/IERC20.json > contracts/out/IERC20.sol/IERC20.abi"
 //go:generate abigen --abi contracts/out/IERC20.sol/IERC20.abi --pkg bindings --type IERC20 --out bindings/ierc20.go
 
+// IERC721.sol
+//go:generate sh -c "jq .abi < contracts/out/IERC721.sol/IERC721.json > contracts/out/IERC721.sol/IERC721.abi"
+//go:generate abigen --abi contracts/out/IERC721.sol/IERC721.abi --pkg bindings --type IERC721 --out bindings/ierc721.go
+
+// IERC1155.sol
+//go:generate sh -c "jq .abi < contracts/out/IERC1155.sol/IERC1155.json > contracts/out/IERC1155.sol/IERC1155.abi"
+//go:generate abigen --abi contracts/out/IERC1155.sol/IERC1155.abi --pkg bindings --type IERC1155 --out bindings/ierc1155.go
+
 // Cartesi contracts
-//go:generate abigen --abi cartesi_abi/CartesiDApp.json --pkg bindings --type CartesiDApp --out bindings/cartesidapp.go
-//go:generate abigen --abi cartesi_abi/DAppAddressRelay.json --pkg bindings --type DAppAddressRelay --out bindings/dappaddressrelay.go
-//go:generate abigen --abi cartesi_abi/ERC1155BatchPortal.json --pkg bindings --type ERC1155BatchPortal --out bindings/erc1155batchportal.go
-//go:generate abigen --abi cartesi_abi/ERC1155SinglePortal.json --pkg bindings --type ERC1155SinglePortal --out bindings/erc1155singleportal.go
-//go:generate abigen --abi cartesi_abi/ERC20Portal.json --pkg bindings --type ERC20Portal --out bindings/erc20portal.go
-//go:generate abigen --abi cartesi_abi/ERC721Portal.json --pkg bindings --type ERC721Portal --out bindings/erc721portal.go
-//go:generate abigen --abi cartesi_abi/EtherPortal.json --pkg bindings --type EtherPortal --out bindings/etherportal.go
-//go:generate abigen --abi cartesi_abi/InputBox.json --pkg bindings --type InputBox --out bindings/inputbox.go
-//go:generate abigen --abi cartesi_abi/InputBox.json --pkg bindings --type InputBox --out bindings/inputbox.go
+// The bytecode comes from the contracts in src/rollups, which implement the ABI in cartesi_abi.
+// The simulated backend deploys them; the other networks use the contracts deployed by Cartesi.
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/CartesiDApp.sol/CartesiDApp.json | cut -c 3- > contracts/out/CartesiDApp.sol/CartesiDApp.bin"
+//go:generate abigen --abi cartesi_abi/CartesiDApp.json --bin contracts/out/CartesiDApp.sol/CartesiDApp.bin --pkg bindings --type CartesiDApp --out bindings/cartesidapp.go
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/CartesiDApp.sol/CartesiDAppFactory.json | cut -c 3- > contracts/out/CartesiDApp.sol/CartesiDAppFactory.bin"
+//go:generate abigen --abi cartesi_abi/CartesiDAppFactory.json --bin contracts/out/CartesiDApp.sol/CartesiDAppFactory.bin --pkg bindings --type CartesiDAppFactory --out bindings/cartesidappfactory.go
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/Portals.sol/DAppAddressRelay.json | cut -c 3- > contracts/out/Portals.sol/DAppAddressRelay.bin"
+//go:generate abigen --abi cartesi_abi/DAppAddressRelay.json --bin contracts/out/Portals.sol/DAppAddressRelay.bin --pkg bindings --type DAppAddressRelay --out bindings/dappaddressrelay.go
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/Portals.sol/ERC1155BatchPortal.json | cut -c 3- > contracts/out/Portals.sol/ERC1155BatchPortal.bin"
+//go:generate abigen --abi cartesi_abi/ERC1155BatchPortal.json --bin contracts/out/Portals.sol/ERC1155BatchPortal.bin --pkg bindings --type ERC1155BatchPortal --out bindings/erc1155batchportal.go
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/Portals.sol/ERC1155SinglePortal.json | cut -c 3- > contracts/out/Portals.sol/ERC1155SinglePortal.bin"
+//go:generate abigen --abi cartesi_abi/ERC1155SinglePortal.json --bin contracts/out/Portals.sol/ERC1155SinglePortal.bin --pkg bindings --type ERC1155SinglePortal --out bindings/erc1155singleportal.go
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/Portals.sol/ERC20Portal.json | cut -c 3- > contracts/out/Portals.sol/ERC20Portal.bin"
+//go:generate abigen --abi cartesi_abi/ERC20Portal.json --bin contracts/out/Portals.sol/ERC20Portal.bin --pkg bindings --type ERC20Portal --out bindings/erc20portal.go
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/Portals.sol/ERC721Portal.json | cut -c 3- > contracts/out/Portals.sol/ERC721Portal.bin"
+//go:generate abigen --abi cartesi_abi/ERC721Portal.json --bin contracts/out/Portals.sol/ERC721Portal.bin --pkg bindings --type ERC721Portal --out bindings/erc721portal.go
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/Portals.sol/EtherPortal.json | cut -c 3- > contracts/out/Portals.sol/EtherPortal.bin"
+//go:generate abigen --abi cartesi_abi/EtherPortal.json --bin contracts/out/Portals.sol/EtherPortal.bin --pkg bindings --type EtherPortal --out bindings/etherportal.go
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/InputBox.sol/InputBox.json | cut -c 3- > contracts/out/InputBox.sol/InputBox.bin"
+//go:generate abigen --abi cartesi_abi/InputBox.json --bin contracts/out/InputBox.sol/InputBox.bin --pkg bindings --type InputBox --out bindings/inputbox.go
+//go:generate abigen --abi cartesi_abi/IConsensus.json --pkg bindings --type IConsensus --out bindings/iconsensus.go
+
+// Authority.sol, the consensus of the DApp in the simulated backend
+//go:generate sh -c "jq .abi < contracts/out/Authority.sol/Authority.json > contracts/out/Authority.sol/Authority.abi"
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/Authority.sol/Authority.json | cut -c 3- > contracts/out/Authority.sol/Authority.bin"
+//go:generate abigen --bin contracts/out/Authority.sol/Authority.bin --abi contracts/out/Authority.sol/Authority.abi --pkg bindings --type Authority --out bindings/authority.go
+//go:generate sh -c "jq .abi < contracts/out/Authority.sol/History.json > contracts/out/Authority.sol/History.abi"
+//go:generate sh -c "jq -r .bytecode.object < contracts/out/Authority.sol/History.json | cut -c 3- > contracts/out/Authority.sol/History.bin"
+//go:generate abigen --bin contracts/out/Authority.sol/History.bin --abi contracts/out/Authority.sol/History.abi --pkg bindings --type History --out bindings/history.go
 
 import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
-// Default gas limit when sending transactions.
+// Gas limit used by the transactions before the gas estimation.
+//
+// Deprecated: the client estimates the gas of each transaction; set TxOptions.GasLimit to
+// use a fixed gas limit instead.
 const DefaultGasLimit = 30_000_000
 
+// Default multiplier applied to the estimated gas when sending transactions.
+const DefaultGasMultiplier = 1.2
+
 // Dev mnemonic used by Foundry/Anvil.
 const FoundryMnemonic = "test test test test test test test test test test test junk"
 
+// Addresses of the contracts in the sunodo devnet.
+//
+// Deprecated: use the fields of LocalhostDeployment, or the deployment of the target
+// network, instead.
 var (
 	AddressCartesiDAppFactory  common.Address
 	AddressDAppAddressRelay    common.Address
@@ -51,13 +89,14 @@ var (
 )
 
 func init() {
-	AddressCartesiDAppFactory = common.HexToAddress("0x7122cd1221C20892234186facfE8615e6743Ab02")
-	AddressDAppAddressRelay = common.HexToAddress("0xF5DE34d6BbC0446E2a45719E718efEbaaE179daE")
-	AddressERC1155BatchPortal = common.HexToAddress("0xedB53860A6B52bbb7561Ad596416ee9965B055Aa")
-	AddressERC1155SinglePortal = common.HexToAddress("0x7CFB0193Ca87eB6e48056885E026552c3A941FC4")
-	AddressERC20Portal = common.HexToAddress("0x9C21AEb2093C32DDbC53eEF24B873BDCd1aDa1DB")
-	AddressERC721Portal = common.HexToAddress("0x237F8DD094C0e47f4236f12b4Fa01d6Dae89fb87")
-	AddressEtherPortal = common.HexToAddress("0xFfdbe43d4c855BF7e0f105c400A50857f53AB044")
-	AddressInputBox = common.HexToAddress("0x59b22D57D4f067708AB0c00552767405926dc768")
-	AddressSunodoToken = common.HexToAddress("0xae7f61eCf06C65405560166b259C54031428A9C4")
+	localhost := LocalhostDeployment()
+	AddressCartesiDAppFactory = localhost.CartesiDAppFactory
+	AddressDAppAddressRelay = localhost.DAppAddressRelay
+	AddressERC1155BatchPortal = localhost.ERC1155BatchPortal
+	AddressERC1155SinglePortal = localhost.ERC1155SinglePortal
+	AddressERC20Portal = localhost.ERC20Portal
+	AddressERC721Portal = localhost.ERC721Portal
+	AddressEtherPortal = localhost.EtherPortal
+	AddressInputBox = localhost.InputBox
+	AddressSunodoToken = localhost.SunodoToken
 }
@@ -0,0 +1,8 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.20;
+
+// Import the token interfaces so forge generates their ABIs.
+import "@openzeppelin/contracts/token/ERC721/IERC721.sol";
+import "@openzeppelin/contracts/token/ERC1155/IERC1155.sol";
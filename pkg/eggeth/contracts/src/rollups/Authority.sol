@@ -0,0 +1,101 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.8;
+
+import "./Common.sol";
+
+/// @notice Consensus in which the owner submits the claims to the history.
+contract Authority is IConsensus, Ownable {
+    IHistory internal history;
+
+    event NewHistory(IHistory history);
+
+    constructor(address _owner) {
+        transferOwnership(_owner);
+    }
+
+    function submitClaim(bytes calldata _claimData) external onlyOwner {
+        history.submitClaim(_claimData);
+    }
+
+    function setHistory(IHistory _history) external onlyOwner {
+        history = _history;
+        emit NewHistory(_history);
+    }
+
+    function getHistory() external view returns (IHistory) {
+        return history;
+    }
+
+    function getClaim(address _dapp, bytes calldata _proofContext)
+        external
+        view
+        override
+        returns (bytes32, uint256, uint256)
+    {
+        return history.getClaim(_dapp, _proofContext);
+    }
+
+    function migrateHistoryToConsensus(address _consensus) external onlyOwner {
+        history.migrateToConsensus(_consensus);
+    }
+
+    function join() external override {
+        emit ApplicationJoined(msg.sender);
+    }
+}
+
+/// @notice Store the claims of each DApp; the proof context is the index of the claim.
+contract History is IHistory, Ownable {
+    struct Claim {
+        bytes32 epochHash;
+        uint128 firstIndex;
+        uint128 lastIndex;
+    }
+
+    mapping(address => Claim[]) internal claims;
+
+    event NewClaimToHistory(address indexed dapp, Claim claim);
+
+    error InvalidInputIndices();
+    error UnclaimedInputs();
+    error InvalidClaimIndex();
+
+    constructor(address _owner) {
+        transferOwnership(_owner);
+    }
+
+    function submitClaim(bytes calldata _claimData) external override onlyOwner {
+        (address dapp, Claim memory claim) = abi.decode(_claimData, (address, Claim));
+        if (claim.firstIndex > claim.lastIndex) {
+            revert InvalidInputIndices();
+        }
+        Claim[] storage dappClaims = claims[dapp];
+        uint256 numDAppClaims = dappClaims.length;
+        if (claim.firstIndex != ((numDAppClaims == 0) ? 0 : (dappClaims[numDAppClaims - 1].lastIndex + 1))) {
+            revert UnclaimedInputs();
+        }
+        dappClaims.push(claim);
+        emit NewClaimToHistory(dapp, claim);
+    }
+
+    function getClaim(address _dapp, bytes calldata _proofContext)
+        external
+        view
+        override
+        returns (bytes32, uint256, uint256)
+    {
+        uint256 claimIndex = abi.decode(_proofContext, (uint256));
+        Claim[] storage dappClaims = claims[_dapp];
+        if (claimIndex >= dappClaims.length) {
+            revert InvalidClaimIndex();
+        }
+        Claim memory claim = dappClaims[claimIndex];
+        return (claim.epochHash, claim.firstIndex, claim.lastIndex);
+    }
+
+    function migrateToConsensus(address _consensus) external override onlyOwner {
+        transferOwnership(_consensus);
+    }
+}
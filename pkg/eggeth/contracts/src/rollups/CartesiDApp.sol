@@ -0,0 +1,163 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.8;
+
+import "./Common.sol";
+
+/// @notice DApp contract that executes the vouchers and validates the notices claimed by
+/// its consensus.
+contract CartesiDApp is IERC721Receiver, ERC1155Holder, ReentrancyGuard, Ownable {
+    using Bitmask for mapping(uint256 => uint256);
+    using LibOutputValidation for OutputValidityProof;
+
+    bytes32 internal immutable templateHash;
+    mapping(uint256 => uint256) internal voucherBitmask;
+    IConsensus internal consensus;
+
+    event NewConsensus(IConsensus newConsensus);
+    event VoucherExecuted(uint256 voucherId);
+
+    error EtherTransferFailed();
+    error OnlyDApp();
+    error VoucherReexecutionNotAllowed();
+
+    constructor(IConsensus _consensus, address _owner, bytes32 _templateHash) {
+        transferOwnership(_owner);
+        templateHash = _templateHash;
+        consensus = _consensus;
+        _consensus.join();
+    }
+
+    function executeVoucher(address _destination, bytes calldata _payload, Proof calldata _proof)
+        external
+        nonReentrant
+        returns (bool)
+    {
+        (bytes32 epochHash, uint256 firstInputIndex, uint256 lastInputIndex) = getClaim(_proof.context);
+        uint256 inputIndex = _proof.validity.validateInputIndexRange(firstInputIndex, lastInputIndex);
+
+        // reverts if proof isn't valid
+        _proof.validity.validateVoucher(_destination, _payload, epochHash);
+
+        uint256 voucherPosition =
+            LibOutputValidation.getBitMaskPosition(_proof.validity.outputIndexWithinInput, inputIndex);
+
+        // check if voucher has been executed
+        if (_wasVoucherExecuted(voucherPosition)) {
+            revert VoucherReexecutionNotAllowed();
+        }
+
+        // execute voucher
+        (bool succ,) = _destination.call(_payload);
+
+        // if properly executed, mark it as executed and emit event
+        if (succ) {
+            voucherBitmask.setBit(voucherPosition, true);
+            emit VoucherExecuted(voucherPosition);
+        }
+        return succ;
+    }
+
+    function wasVoucherExecuted(uint256 _inputIndex, uint256 _outputIndexWithinInput)
+        external
+        view
+        returns (bool)
+    {
+        uint256 voucherPosition = LibOutputValidation.getBitMaskPosition(_outputIndexWithinInput, _inputIndex);
+        return _wasVoucherExecuted(voucherPosition);
+    }
+
+    function _wasVoucherExecuted(uint256 _voucherPosition) internal view returns (bool) {
+        return voucherBitmask.getBit(_voucherPosition);
+    }
+
+    function validateNotice(bytes calldata _notice, Proof calldata _proof) external view returns (bool) {
+        (bytes32 epochHash, uint256 firstInputIndex, uint256 lastInputIndex) = getClaim(_proof.context);
+
+        // reverts if proof isn't valid
+        _proof.validity.validateInputIndexRange(firstInputIndex, lastInputIndex);
+        _proof.validity.validateNotice(_notice, epochHash);
+        return true;
+    }
+
+    function getClaim(bytes calldata _proofContext) internal view returns (bytes32, uint256, uint256) {
+        return consensus.getClaim(address(this), _proofContext);
+    }
+
+    function migrateToConsensus(IConsensus _newConsensus) external onlyOwner {
+        consensus = _newConsensus;
+        _newConsensus.join();
+        emit NewConsensus(_newConsensus);
+    }
+
+    function getTemplateHash() external view returns (bytes32) {
+        return templateHash;
+    }
+
+    function getConsensus() external view returns (IConsensus) {
+        return consensus;
+    }
+
+    function supportsInterface(bytes4 interfaceId) public view override returns (bool) {
+        return interfaceId == type(IERC721Receiver).interfaceId || super.supportsInterface(interfaceId);
+    }
+
+    function withdrawEther(address _receiver, uint256 _value) external {
+        if (msg.sender != address(this)) {
+            revert OnlyDApp();
+        }
+        (bool sent,) = _receiver.call{value: _value}("");
+        if (!sent) {
+            revert EtherTransferFailed();
+        }
+    }
+
+    function onERC721Received(address, address, uint256, bytes calldata)
+        external
+        virtual
+        override
+        returns (bytes4)
+    {
+        return this.onERC721Received.selector;
+    }
+
+    receive() external payable {}
+}
+
+/// @notice Deploy CartesiDApp contracts.
+contract CartesiDAppFactory {
+    event ApplicationCreated(
+        IConsensus indexed consensus, address dappOwner, bytes32 templateHash, CartesiDApp application
+    );
+
+    function newApplication(IConsensus _consensus, address _dappOwner, bytes32 _templateHash)
+        external
+        returns (CartesiDApp)
+    {
+        CartesiDApp application = new CartesiDApp(_consensus, _dappOwner, _templateHash);
+        emit ApplicationCreated(_consensus, _dappOwner, _templateHash, application);
+        return application;
+    }
+
+    function newApplication(IConsensus _consensus, address _dappOwner, bytes32 _templateHash, bytes32 _salt)
+        external
+        returns (CartesiDApp)
+    {
+        CartesiDApp application = new CartesiDApp{salt: _salt}(_consensus, _dappOwner, _templateHash);
+        emit ApplicationCreated(_consensus, _dappOwner, _templateHash, application);
+        return application;
+    }
+
+    function calculateApplicationAddress(
+        IConsensus _consensus,
+        address _dappOwner,
+        bytes32 _templateHash,
+        bytes32 _salt
+    ) external view returns (address) {
+        bytes32 bytecodeHash = keccak256(
+            abi.encodePacked(type(CartesiDApp).creationCode, abi.encode(_consensus, _dappOwner, _templateHash))
+        );
+        return address(uint160(uint256(keccak256(abi.encodePacked(bytes1(0xff), address(this), _salt, bytecodeHash)))));
+    }
+}
@@ -0,0 +1,461 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT
+
+// Implementation of the Cartesi Rollups v1 contracts for the simulated backend.
+// The contracts follow the ABI and the behavior of cartesi/rollups-contracts v1.0, so the
+// ETHClient works the same way with them and with the sunodo devnet. They don't depend on
+// OpenZeppelin, so they build without the lib submodules.
+
+pragma solidity ^0.8.8;
+
+interface IERC165 {
+    function supportsInterface(bytes4 interfaceId) external view returns (bool);
+}
+
+interface IERC20 {
+    function transferFrom(address from, address to, uint256 amount) external returns (bool);
+    function transfer(address to, uint256 amount) external returns (bool);
+}
+
+interface IERC721 {
+    function safeTransferFrom(address from, address to, uint256 tokenId, bytes calldata data) external;
+}
+
+interface IERC1155 {
+    function safeTransferFrom(address from, address to, uint256 id, uint256 value, bytes calldata data)
+        external;
+
+    function safeBatchTransferFrom(
+        address from,
+        address to,
+        uint256[] calldata ids,
+        uint256[] calldata values,
+        bytes calldata data
+    ) external;
+}
+
+interface IERC721Receiver {
+    function onERC721Received(address operator, address from, uint256 tokenId, bytes calldata data)
+        external
+        returns (bytes4);
+}
+
+interface IERC1155Receiver is IERC165 {
+    function onERC1155Received(address operator, address from, uint256 id, uint256 value, bytes calldata data)
+        external
+        returns (bytes4);
+
+    function onERC1155BatchReceived(
+        address operator,
+        address from,
+        uint256[] calldata ids,
+        uint256[] calldata values,
+        bytes calldata data
+    ) external returns (bytes4);
+}
+
+/// @notice Ownership with the semantics of OpenZeppelin 4.
+abstract contract Ownable {
+    address private _owner;
+
+    event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
+
+    constructor() {
+        _transferOwnership(msg.sender);
+    }
+
+    modifier onlyOwner() {
+        require(_owner == msg.sender, "Ownable: caller is not the owner");
+        _;
+    }
+
+    function owner() public view virtual returns (address) {
+        return _owner;
+    }
+
+    function renounceOwnership() public virtual onlyOwner {
+        _transferOwnership(address(0));
+    }
+
+    function transferOwnership(address newOwner) public virtual onlyOwner {
+        require(newOwner != address(0), "Ownable: new owner is the zero address");
+        _transferOwnership(newOwner);
+    }
+
+    function _transferOwnership(address newOwner) internal virtual {
+        address oldOwner = _owner;
+        _owner = newOwner;
+        emit OwnershipTransferred(oldOwner, newOwner);
+    }
+}
+
+/// @notice Reentrancy guard with the semantics of OpenZeppelin 4.
+abstract contract ReentrancyGuard {
+    uint256 private _status = 1;
+
+    modifier nonReentrant() {
+        require(_status != 2, "ReentrancyGuard: reentrant call");
+        _status = 2;
+        _;
+        _status = 1;
+    }
+}
+
+/// @notice Receive ERC-1155 tokens.
+abstract contract ERC1155Holder is IERC1155Receiver {
+    function supportsInterface(bytes4 interfaceId) public view virtual override returns (bool) {
+        return interfaceId == type(IERC1155Receiver).interfaceId || interfaceId == type(IERC165).interfaceId;
+    }
+
+    function onERC1155Received(address, address, uint256, uint256, bytes memory)
+        public
+        virtual
+        override
+        returns (bytes4)
+    {
+        return this.onERC1155Received.selector;
+    }
+
+    function onERC1155BatchReceived(address, address, uint256[] memory, uint256[] memory, bytes memory)
+        public
+        virtual
+        override
+        returns (bytes4)
+    {
+        return this.onERC1155BatchReceived.selector;
+    }
+}
+
+/// @notice Constants of the Cartesi Machine.
+library CanonicalMachine {
+    /// @notice Keccak-256 output size (32 bytes).
+    uint256 constant KECCAK_LOG2_SIZE = 5;
+
+    /// @notice Maximum input size (~2 megabytes); the offset and size fields use the extra 64 bytes.
+    uint256 constant INPUT_MAX_SIZE = (1 << 21) - 64;
+
+    /// @notice Maximum voucher metadata memory range (2 megabytes).
+    uint256 constant VOUCHER_METADATA_LOG2_SIZE = 21;
+
+    /// @notice Maximum notice metadata memory range (2 megabytes).
+    uint256 constant NOTICE_METADATA_LOG2_SIZE = 21;
+
+    /// @notice Maximum epoch voucher memory range (128 gigabytes).
+    uint256 constant EPOCH_VOUCHER_LOG2_SIZE = 37;
+
+    /// @notice Maximum epoch notice memory range (128 gigabytes).
+    uint256 constant EPOCH_NOTICE_LOG2_SIZE = 37;
+
+    /// @notice Get the position of a value in a memory range of values with the given size.
+    function getIntraMemoryRangePosition(uint256 index, uint256 log2Size) internal pure returns (uint256) {
+        return index << log2Size;
+    }
+}
+
+/// @notice Encoding of the inputs sent by the portals and the relays.
+library InputEncoding {
+    function encodeEtherDeposit(address sender, uint256 value, bytes calldata execLayerData)
+        internal
+        pure
+        returns (bytes memory)
+    {
+        return abi.encodePacked(sender, value, execLayerData);
+    }
+
+    function encodeERC20Deposit(bool ret, IERC20 token, address sender, uint256 amount, bytes calldata execLayerData)
+        internal
+        pure
+        returns (bytes memory)
+    {
+        return abi.encodePacked(ret, token, sender, amount, execLayerData);
+    }
+
+    function encodeERC721Deposit(
+        IERC721 token,
+        address sender,
+        uint256 tokenId,
+        bytes calldata baseLayerData,
+        bytes calldata execLayerData
+    ) internal pure returns (bytes memory) {
+        bytes memory data = abi.encode(baseLayerData, execLayerData);
+        return abi.encodePacked(token, sender, tokenId, data);
+    }
+
+    function encodeSingleERC1155Deposit(
+        IERC1155 token,
+        address sender,
+        uint256 tokenId,
+        uint256 value,
+        bytes calldata baseLayerData,
+        bytes calldata execLayerData
+    ) internal pure returns (bytes memory) {
+        bytes memory data = abi.encode(baseLayerData, execLayerData);
+        return abi.encodePacked(token, sender, tokenId, value, data);
+    }
+
+    function encodeBatchERC1155Deposit(
+        IERC1155 token,
+        address sender,
+        uint256[] calldata tokenIds,
+        uint256[] calldata values,
+        bytes calldata baseLayerData,
+        bytes calldata execLayerData
+    ) internal pure returns (bytes memory) {
+        bytes memory data = abi.encode(tokenIds, values, baseLayerData, execLayerData);
+        return abi.encodePacked(token, sender, data);
+    }
+
+    function encodeDAppAddressRelay(address dapp) internal pure returns (bytes memory) {
+        return abi.encodePacked(dapp);
+    }
+}
+
+/// @notice Merkle trees of the Cartesi Machine memory, with 8-byte words as leaves.
+library MerkleV2 {
+    uint256 constant L_WORD_SIZE = 3;
+
+    /// @notice Get the root after replacing the node at the given position of the drive.
+    function getRootAfterReplacementInDrive(
+        uint256 position,
+        uint256 logSizeOfReplacement,
+        uint256 logSizeOfFullDrive,
+        bytes32 replacement,
+        bytes32[] calldata siblings
+    ) internal pure returns (bytes32) {
+        require(
+            logSizeOfFullDrive >= logSizeOfReplacement && logSizeOfReplacement >= 3 && logSizeOfFullDrive <= 64,
+            "3 <= logSizeOfReplacement <= logSizeOfFullDrive <= 64"
+        );
+        uint256 size = 1 << logSizeOfReplacement;
+        require(((size - 1) & position) == 0, "Position is not aligned");
+        require(siblings.length == logSizeOfFullDrive - logSizeOfReplacement, "Proof length does not match");
+        for (uint256 i; i < siblings.length; i++) {
+            if ((position & (size << i)) == 0) {
+                replacement = keccak256(abi.encodePacked(replacement, siblings[i]));
+            } else {
+                replacement = keccak256(abi.encodePacked(siblings[i], replacement));
+            }
+        }
+        return replacement;
+    }
+
+    /// @notice Get the Merkle root of the data padded with zeros to 2^log2Size bytes.
+    function getMerkleRootFromBytes(bytes memory data, uint256 log2Size) internal pure returns (bytes32) {
+        require(log2Size >= L_WORD_SIZE && log2Size <= 64, "3 <= log2Size <= 64");
+        require(data.length <= (1 << log2Size), "data is bigger than drive");
+        return getMerkleRoot(data, 0, log2Size);
+    }
+
+    function getMerkleRoot(bytes memory data, uint256 start, uint256 log2Size) private pure returns (bytes32) {
+        if (log2Size == L_WORD_SIZE) {
+            bytes8 word;
+            for (uint256 i; i < 8; i++) {
+                if (start + i < data.length) {
+                    word |= bytes8(data[start + i]) >> (i * 8);
+                }
+            }
+            return keccak256(abi.encodePacked(word));
+        }
+        uint256 half = 1 << (log2Size - 1);
+        return keccak256(
+            abi.encodePacked(getMerkleRoot(data, start, log2Size - 1), getMerkleRoot(data, start + half, log2Size - 1))
+        );
+    }
+}
+
+/// @notice Bit array stored in a mapping of 256-bit words.
+library Bitmask {
+    function setBit(mapping(uint256 => uint256) storage bitmask, uint256 bit, bool value) internal {
+        uint256 positionOfMask = bit >> 8;
+        uint256 mask = 1 << (bit & 0xff);
+        if (value) {
+            bitmask[positionOfMask] |= mask;
+        } else {
+            bitmask[positionOfMask] &= ~mask;
+        }
+    }
+
+    function getBit(mapping(uint256 => uint256) storage bitmask, uint256 bit) internal view returns (bool) {
+        uint256 positionOfMask = bit >> 8;
+        uint256 mask = 1 << (bit & 0xff);
+        return (bitmask[positionOfMask] & mask) != 0;
+    }
+}
+
+/// @param inputIndexWithinEpoch Which input, inside the epoch, the output belongs to
+/// @param outputIndexWithinInput Index of output emitted by the input
+/// @param outputHashesRootHash Merkle root of hashes of outputs emitted by the input
+/// @param vouchersEpochRootHash Merkle root of all epoch's voucher metadata hashes
+/// @param noticesEpochRootHash Merkle root of all epoch's notice metadata hashes
+/// @param machineStateHash Hash of the machine state claimed this epoch
+/// @param outputHashInOutputHashesSiblings Proof that this output metadata is in metadata memory range
+/// @param outputHashesInEpochSiblings Proof that this output metadata is in epoch's output memory range
+struct OutputValidityProof {
+    uint64 inputIndexWithinEpoch;
+    uint64 outputIndexWithinInput;
+    bytes32 outputHashesRootHash;
+    bytes32 vouchersEpochRootHash;
+    bytes32 noticesEpochRootHash;
+    bytes32 machineStateHash;
+    bytes32[] outputHashInOutputHashesSiblings;
+    bytes32[] outputHashesInEpochSiblings;
+}
+
+/// @param validity A validity proof for the output
+/// @param context Data for querying the right claim from the current consensus contract
+struct Proof {
+    OutputValidityProof validity;
+    bytes context;
+}
+
+/// @notice Validation of the outputs against the claim of the epoch.
+library LibOutputValidation {
+    error IncorrectEpochHash();
+    error IncorrectOutputsEpochRootHash();
+    error IncorrectOutputHashesRootHash();
+    error InputIndexOutOfClaimBounds();
+
+    function validateEncodedOutput(
+        OutputValidityProof calldata v,
+        bytes memory encodedOutput,
+        bytes32 epochHash,
+        bytes32 outputsEpochRootHash,
+        uint256 outputEpochLog2Size,
+        uint256 outputHashesLog2Size
+    ) internal pure {
+        // prove that outputs hash is represented in a finalized epoch
+        if (keccak256(abi.encodePacked(v.vouchersEpochRootHash, v.noticesEpochRootHash, v.machineStateHash)) != epochHash) {
+            revert IncorrectEpochHash();
+        }
+
+        // prove that output metadata memory range is contained in epoch's output memory range
+        if (
+            MerkleV2.getRootAfterReplacementInDrive(
+                CanonicalMachine.getIntraMemoryRangePosition(v.inputIndexWithinEpoch, CanonicalMachine.KECCAK_LOG2_SIZE),
+                CanonicalMachine.KECCAK_LOG2_SIZE,
+                outputEpochLog2Size,
+                v.outputHashesRootHash,
+                v.outputHashesInEpochSiblings
+            ) != outputsEpochRootHash
+        ) {
+            revert IncorrectOutputsEpochRootHash();
+        }
+
+        // The hash of the output is treated as data with 8-byte words, so the leaf of the
+        // metadata memory range is the Merkle root of the hash.
+        bytes32 merkleRootOfHashOfOutput = MerkleV2.getMerkleRootFromBytes(
+            abi.encodePacked(keccak256(encodedOutput)), CanonicalMachine.KECCAK_LOG2_SIZE
+        );
+
+        // prove that output hash is contained in the metadata memory range
+        if (
+            MerkleV2.getRootAfterReplacementInDrive(
+                CanonicalMachine.getIntraMemoryRangePosition(v.outputIndexWithinInput, CanonicalMachine.KECCAK_LOG2_SIZE),
+                CanonicalMachine.KECCAK_LOG2_SIZE,
+                outputHashesLog2Size,
+                merkleRootOfHashOfOutput,
+                v.outputHashInOutputHashesSiblings
+            ) != v.outputHashesRootHash
+        ) {
+            revert IncorrectOutputHashesRootHash();
+        }
+    }
+
+    function validateVoucher(
+        OutputValidityProof calldata v,
+        address destination,
+        bytes calldata payload,
+        bytes32 epochHash
+    ) internal pure {
+        bytes memory encodedVoucher = abi.encode(destination, payload);
+        validateEncodedOutput(
+            v,
+            encodedVoucher,
+            epochHash,
+            v.vouchersEpochRootHash,
+            CanonicalMachine.EPOCH_VOUCHER_LOG2_SIZE,
+            CanonicalMachine.VOUCHER_METADATA_LOG2_SIZE
+        );
+    }
+
+    function validateNotice(OutputValidityProof calldata v, bytes calldata notice, bytes32 epochHash)
+        internal
+        pure
+    {
+        bytes memory encodedNotice = abi.encode(notice);
+        validateEncodedOutput(
+            v,
+            encodedNotice,
+            epochHash,
+            v.noticesEpochRootHash,
+            CanonicalMachine.EPOCH_NOTICE_LOG2_SIZE,
+            CanonicalMachine.NOTICE_METADATA_LOG2_SIZE
+        );
+    }
+
+    /// @notice Get the position of a voucher on the bit mask.
+    function getBitMaskPosition(uint256 output, uint256 input) internal pure returns (uint256) {
+        // output * 2 ** 128 + input
+        // this shouldn't overflow because it is impossible to have > 2**128 outputs
+        // and because the maximum value for input is 2**128
+        return ((output << 128) | input);
+    }
+
+    /// @notice Validate the input index range and calculate the input index.
+    function validateInputIndexRange(OutputValidityProof calldata v, uint256 firstInputIndex, uint256 lastInputIndex)
+        internal
+        pure
+        returns (uint256)
+    {
+        uint256 inputIndex = firstInputIndex + v.inputIndexWithinEpoch;
+        if (inputIndex > lastInputIndex) {
+            revert InputIndexOutOfClaimBounds();
+        }
+        return inputIndex;
+    }
+}
+
+interface IInputBox {
+    event InputAdded(address indexed dapp, uint256 indexed inputIndex, address sender, bytes input);
+
+    function addInput(address _dapp, bytes calldata _input) external returns (bytes32);
+
+    function getNumberOfInputs(address _dapp) external view returns (uint256);
+
+    function getInputHash(address _dapp, uint256 _index) external view returns (bytes32);
+}
+
+interface IConsensus {
+    event ApplicationJoined(address indexed application);
+
+    function getClaim(address _dapp, bytes calldata _proofContext)
+        external
+        view
+        returns (bytes32 epochHash_, uint256 firstInputIndex_, uint256 lastInputIndex_);
+
+    function join() external;
+}
+
+interface IHistory {
+    function submitClaim(bytes calldata _claimData) external;
+
+    function migrateToConsensus(address _consensus) external;
+
+    function getClaim(address _dapp, bytes calldata _proofContext)
+        external
+        view
+        returns (bytes32, uint256, uint256);
+}
+
+/// @notice Portals hold a reference to the InputBox.
+abstract contract InputRelay {
+    IInputBox internal immutable inputBox;
+
+    constructor(IInputBox _inputBox) {
+        inputBox = _inputBox;
+    }
+
+    function getInputBox() external view returns (IInputBox) {
+        return inputBox;
+    }
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.8;
+
+import "./Common.sol";
+
+/// @notice Store the hashes of the inputs of each DApp.
+contract InputBox is IInputBox {
+    /// @notice Mapping from DApp address to list of input hashes.
+    mapping(address => bytes32[]) internal inputBoxes;
+
+    error InputSizeExceedsLimit();
+
+    function addInput(address _dapp, bytes calldata _input) external override returns (bytes32) {
+        bytes32[] storage inputBox = inputBoxes[_dapp];
+        uint256 inputIndex = inputBox.length;
+        bytes32 inputHash = computeInputHash(msg.sender, block.number, block.timestamp, _input, inputIndex);
+        inputBox.push(inputHash);
+        emit InputAdded(_dapp, inputIndex, msg.sender, _input);
+        return inputHash;
+    }
+
+    function getNumberOfInputs(address _dapp) external view override returns (uint256) {
+        return inputBoxes[_dapp].length;
+    }
+
+    function getInputHash(address _dapp, uint256 _index) external view override returns (bytes32) {
+        return inputBoxes[_dapp][_index];
+    }
+
+    function computeInputHash(
+        address sender,
+        uint256 blockNumber,
+        uint256 blockTimestamp,
+        bytes calldata input,
+        uint256 inputIndex
+    ) internal pure returns (bytes32) {
+        if (input.length > CanonicalMachine.INPUT_MAX_SIZE) {
+            revert InputSizeExceedsLimit();
+        }
+        bytes32 keccakMetadata = keccak256(
+            abi.encode(
+                sender,
+                blockNumber,
+                blockTimestamp,
+                0, // epoch index
+                inputIndex // input index in the input box
+            )
+        );
+        bytes32 keccakInput = keccak256(input);
+        return keccak256(abi.encode(keccakMetadata, keccakInput));
+    }
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.8;
+
+import "./Common.sol";
+
+/// @notice Transfer Ether to a DApp and add an input to notify it.
+contract EtherPortal is InputRelay {
+    error EtherTransferFailed();
+
+    constructor(IInputBox _inputBox) InputRelay(_inputBox) {}
+
+    function depositEther(address _dapp, bytes calldata _execLayerData) external payable {
+        // We used to call `transfer()` but it's not considered safe,
+        // as it assumes gas costs are immutable (they are not).
+        (bool success,) = _dapp.call{value: msg.value}("");
+        if (!success) {
+            revert EtherTransferFailed();
+        }
+        bytes memory input = InputEncoding.encodeEtherDeposit(msg.sender, msg.value, _execLayerData);
+        inputBox.addInput(_dapp, input);
+    }
+}
+
+/// @notice Transfer ERC-20 tokens to a DApp and add an input to notify it.
+contract ERC20Portal is InputRelay {
+    constructor(IInputBox _inputBox) InputRelay(_inputBox) {}
+
+    function depositERC20Tokens(IERC20 _token, address _dapp, uint256 _amount, bytes calldata _execLayerData)
+        external
+    {
+        bool success = _token.transferFrom(msg.sender, _dapp, _amount);
+        bytes memory input = InputEncoding.encodeERC20Deposit(success, _token, msg.sender, _amount, _execLayerData);
+        inputBox.addInput(_dapp, input);
+    }
+}
+
+/// @notice Transfer an ERC-721 token to a DApp and add an input to notify it.
+contract ERC721Portal is InputRelay {
+    constructor(IInputBox _inputBox) InputRelay(_inputBox) {}
+
+    function depositERC721Token(
+        IERC721 _token,
+        address _dapp,
+        uint256 _tokenId,
+        bytes calldata _baseLayerData,
+        bytes calldata _execLayerData
+    ) external {
+        _token.safeTransferFrom(msg.sender, _dapp, _tokenId, _baseLayerData);
+        bytes memory input =
+            InputEncoding.encodeERC721Deposit(_token, msg.sender, _tokenId, _baseLayerData, _execLayerData);
+        inputBox.addInput(_dapp, input);
+    }
+}
+
+/// @notice Transfer an ERC-1155 token to a DApp and add an input to notify it.
+contract ERC1155SinglePortal is InputRelay {
+    constructor(IInputBox _inputBox) InputRelay(_inputBox) {}
+
+    function depositSingleERC1155Token(
+        IERC1155 _token,
+        address _dapp,
+        uint256 _tokenId,
+        uint256 _value,
+        bytes calldata _baseLayerData,
+        bytes calldata _execLayerData
+    ) external {
+        _token.safeTransferFrom(msg.sender, _dapp, _tokenId, _value, _baseLayerData);
+        bytes memory input = InputEncoding.encodeSingleERC1155Deposit(
+            _token, msg.sender, _tokenId, _value, _baseLayerData, _execLayerData
+        );
+        inputBox.addInput(_dapp, input);
+    }
+}
+
+/// @notice Transfer a batch of ERC-1155 tokens to a DApp and add an input to notify it.
+contract ERC1155BatchPortal is InputRelay {
+    constructor(IInputBox _inputBox) InputRelay(_inputBox) {}
+
+    function depositBatchERC1155Token(
+        IERC1155 _token,
+        address _dapp,
+        uint256[] calldata _tokenIds,
+        uint256[] calldata _values,
+        bytes calldata _baseLayerData,
+        bytes calldata _execLayerData
+    ) external {
+        _token.safeBatchTransferFrom(msg.sender, _dapp, _tokenIds, _values, _baseLayerData);
+        bytes memory input = InputEncoding.encodeBatchERC1155Deposit(
+            _token, msg.sender, _tokenIds, _values, _baseLayerData, _execLayerData
+        );
+        inputBox.addInput(_dapp, input);
+    }
+}
+
+/// @notice Send the address of a DApp to itself as an input.
+contract DAppAddressRelay is InputRelay {
+    constructor(IInputBox _inputBox) InputRelay(_inputBox) {}
+
+    function relayDAppAddress(address _dapp) external {
+        bytes memory input = InputEncoding.encodeDAppAddressRelay(_dapp);
+        inputBox.addInput(_dapp, input);
+    }
+}
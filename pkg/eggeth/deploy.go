@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gligneul/eggroll/internal/sunodo"
 	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
 )
 
@@ -34,9 +35,28 @@ func DeployTestERC20(ctx context.Context, endpoint string) (common.Address, erro
 		return common.Address{}, fmt.Errorf("failed to create signer: %v", err)
 	}
 
+	return deployTestERC20(ctx, client, signer)
+}
+
+// Deploy a ERC20 contract for testing with the given signer.
+// The signer account receives the initial supply.
+// It returns the the address of the ERC20 contract.
+func DeployTestERC20WithSigner(ctx context.Context, endpoint string, signer Signer) (
+	common.Address, error) {
+
+	client, err := ethclient.Dial(endpoint)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to connect to Ethereum: %v", err)
+	}
+	return deployTestERC20(ctx, client, signer)
+}
+
+func deployTestERC20(ctx context.Context, client *ethclient.Client, signer Signer) (
+	common.Address, error) {
+
 	var address common.Address
-	_, err = sendTransaction(
-		ctx, client, signer, big.NewInt(0), DefaultGasLimit,
+	_, err := sendTransaction(
+		ctx, client, newNonceManager(), signer, big.NewInt(0), MakeTxOptions(),
 		func(txOpts *bind.TransactOpts) (tx *types.Transaction, err error) {
 			address, tx, _, err = bindings.DeployTestERC20(
 				txOpts, client, signer.Account())
@@ -46,6 +66,65 @@ func DeployTestERC20(ctx context.Context, endpoint string) (common.Address, erro
 	if err != nil {
 		return common.Address{}, err
 	}
-
 	return address, nil
 }
+
+// Deploy a new DApp contract with the CartesiDAppFactory of the client's deployment.
+// The consensus is the contract that validates the claims of the DApp, usually an Authority.
+// If the owner is zero, use the signer account.
+// If the template hash is zero, read it from the .sunodo/image/hash file.
+// It returns the address of the new DApp contract.
+func (c *ETHClient) DeployDApp(
+	ctx context.Context,
+	signer Signer,
+	consensus common.Address,
+	owner common.Address,
+	templateHash common.Hash,
+	salt common.Hash,
+	opts *TxOptions,
+) (common.Address, error) {
+	if owner == (common.Address{}) {
+		owner = signer.Account()
+	}
+	if templateHash == (common.Hash{}) {
+		var err error
+		templateHash, err = sunodo.GetMachineHash()
+		if err != nil {
+			return common.Address{}, err
+		}
+	}
+	factory, err := bindings.NewCartesiDAppFactory(c.deployment.CartesiDAppFactory, c.client)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to connect to CartesiDAppFactory: %v", err)
+	}
+	receipt, err := sendTransaction(
+		ctx, c.client, c.nonces, signer, big.NewInt(0), c.txOptions(opts),
+		func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+			return factory.NewApplication(txOpts, consensus, owner, templateHash, salt)
+		},
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+	for _, log := range receipt.Logs {
+		if log.Address != c.deployment.CartesiDAppFactory {
+			continue
+		}
+		created, err := factory.ParseApplicationCreated(*log)
+		if err != nil {
+			return common.Address{}, fmt.Errorf("failed to parse application created: %v", err)
+		}
+		return created.Application, nil
+	}
+	return common.Address{}, fmt.Errorf("application created event not found")
+}
+
+// Get the consensus of the client's DApp contract.
+func (c *ETHClient) Consensus(ctx context.Context) (common.Address, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	consensus, err := c.dapp.GetConsensus(opts)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get consensus: %v", err)
+	}
+	return consensus, nil
+}
@@ -0,0 +1,235 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+)
+
+// Addresses of the Cartesi Rollups contracts deployed in a network.
+type Deployment struct {
+	Network             string
+	ChainID             uint64
+	CartesiDAppFactory  common.Address
+	DAppAddressRelay    common.Address
+	ERC1155BatchPortal  common.Address
+	ERC1155SinglePortal common.Address
+	ERC20Portal         common.Address
+	ERC721Portal        common.Address
+	EtherPortal         common.Address
+	InputBox            common.Address
+
+	// Token of the sunodo devnet; zero in the networks without it.
+	SunodoToken common.Address
+
+	// Block of the InputBox deployment; the client doesn't look for inputs before it.
+	InputBoxBlock uint64
+}
+
+// Return the deployment of the sunodo devnet, which runs in localhost.
+func LocalhostDeployment() Deployment {
+	return Deployment{
+		Network:             "localhost",
+		ChainID:             31337,
+		CartesiDAppFactory:  common.HexToAddress("0x7122cd1221C20892234186facfE8615e6743Ab02"),
+		DAppAddressRelay:    common.HexToAddress("0xF5DE34d6BbC0446E2a45719E718efEbaaE179daE"),
+		ERC1155BatchPortal:  common.HexToAddress("0xedB53860A6B52bbb7561Ad596416ee9965B055Aa"),
+		ERC1155SinglePortal: common.HexToAddress("0x7CFB0193Ca87eB6e48056885E026552c3A941FC4"),
+		ERC20Portal:         common.HexToAddress("0x9C21AEb2093C32DDbC53eEF24B873BDCd1aDa1DB"),
+		ERC721Portal:        common.HexToAddress("0x237F8DD094C0e47f4236f12b4Fa01d6Dae89fb87"),
+		EtherPortal:         common.HexToAddress("0xFfdbe43d4c855BF7e0f105c400A50857f53AB044"),
+		InputBox:            common.HexToAddress("0x59b22D57D4f067708AB0c00552767405926dc768"),
+		SunodoToken:         common.HexToAddress("0xae7f61eCf06C65405560166b259C54031428A9C4"),
+	}
+}
+
+// Return a pointer to the address of each contract, indexed by the contract name.
+func (d *Deployment) contracts() map[string]*common.Address {
+	return map[string]*common.Address{
+		"CartesiDAppFactory":  &d.CartesiDAppFactory,
+		"DAppAddressRelay":    &d.DAppAddressRelay,
+		"ERC1155BatchPortal":  &d.ERC1155BatchPortal,
+		"ERC1155SinglePortal": &d.ERC1155SinglePortal,
+		"ERC20Portal":         &d.ERC20Portal,
+		"ERC721Portal":        &d.ERC721Portal,
+		"EtherPortal":         &d.EtherPortal,
+		"InputBox":            &d.InputBox,
+		"SunodoToken":         &d.SunodoToken,
+	}
+}
+
+// Contracts that are not required in every deployment.
+var optionalContracts = map[string]bool{
+	"SunodoToken": true,
+}
+
+// Check whether the deployment has the address of every contract.
+func (d *Deployment) Validate() error {
+	var missing []string
+	for name, address := range d.contracts() {
+		if *address == (common.Address{}) && !optionalContracts[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) != 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("missing address of %v", missing)
+	}
+	return nil
+}
+
+// Set the address of the contract with the given name.
+// Ignore contracts that are not part of the deployment.
+func (d *Deployment) setAddress(name string, hexAddress string) error {
+	address, ok := d.contracts()[name]
+	if !ok {
+		return nil
+	}
+	if !common.IsHexAddress(hexAddress) {
+		return fmt.Errorf("invalid address for %v: %v", name, hexAddress)
+	}
+	*address = common.HexToAddress(hexAddress)
+	return nil
+}
+
+// Load the deployment from the JSON exported by the Cartesi Rollups package, in the
+// deployments/<network>.json format.
+func LoadDeploymentJSON(data []byte) (Deployment, error) {
+	var export struct {
+		Name      string          `json:"name"`
+		ChainID   json.RawMessage `json:"chainId"`
+		Contracts map[string]struct {
+			Address string `json:"address"`
+		} `json:"contracts"`
+	}
+	if err := json.Unmarshal(data, &export); err != nil {
+		return Deployment{}, fmt.Errorf("failed to decode deployment: %v", err)
+	}
+	chainId, err := decodeChainID(export.ChainID)
+	if err != nil {
+		return Deployment{}, err
+	}
+	deployment := Deployment{
+		Network: export.Name,
+		ChainID: chainId,
+	}
+	for name, contract := range export.Contracts {
+		if err := deployment.setAddress(name, contract.Address); err != nil {
+			return Deployment{}, err
+		}
+	}
+	if err := deployment.Validate(); err != nil {
+		return Deployment{}, err
+	}
+	return deployment, nil
+}
+
+// The chain ID may be encoded as a number or as a string.
+func decodeChainID(raw json.RawMessage) (uint64, error) {
+	if len(raw) == 0 {
+		return 0, nil
+	}
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		raw = json.RawMessage(str)
+	}
+	chainId, err := strconv.ParseUint(string(raw), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid chain id: %v", string(raw))
+	}
+	return chainId, nil
+}
+
+// Load the deployment from a YAML file with the network, the chain ID, the optional
+// block of the InputBox deployment, and the addresses of the contracts. For instance:
+//
+//	network: sepolia
+//	chainId: 11155111
+//	contracts:
+//	  InputBox: "0x59b22D57D4f067708AB0c00552767405926dc768"
+//	  ...
+func LoadDeploymentYAML(data []byte) (Deployment, error) {
+	var file struct {
+		Network       string            `yaml:"network"`
+		ChainID       uint64            `yaml:"chainId"`
+		InputBoxBlock uint64            `yaml:"inputBoxBlock"`
+		Contracts     map[string]string `yaml:"contracts"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return Deployment{}, fmt.Errorf("failed to decode deployment: %v", err)
+	}
+	deployment := Deployment{
+		Network:       file.Network,
+		ChainID:       file.ChainID,
+		InputBoxBlock: file.InputBoxBlock,
+	}
+	for name, address := range file.Contracts {
+		if err := deployment.setAddress(name, address); err != nil {
+			return Deployment{}, err
+		}
+	}
+	if err := deployment.Validate(); err != nil {
+		return Deployment{}, err
+	}
+	return deployment, nil
+}
+
+// Load the deployment from a JSON or YAML file, according to the file extension.
+func LoadDeploymentFile(path string) (Deployment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Deployment{}, fmt.Errorf("failed to read deployment: %v", err)
+	}
+	switch filepath.Ext(path) {
+	case ".json":
+		return LoadDeploymentJSON(data)
+	case ".yaml", ".yml":
+		return LoadDeploymentYAML(data)
+	default:
+		return Deployment{}, fmt.Errorf("unknown deployment format: %v", path)
+	}
+}
+
+// Registered deployments indexed by network name.
+var (
+	deploymentsMutex sync.Mutex
+	deployments      = map[string]Deployment{
+		"localhost": LocalhostDeployment(),
+	}
+)
+
+// Register the deployment, so it can be retrieved by its network name.
+// Replace the previous deployment of the same network, if any.
+func RegisterDeployment(deployment Deployment) error {
+	if deployment.Network == "" {
+		return fmt.Errorf("missing network name")
+	}
+	if err := deployment.Validate(); err != nil {
+		return err
+	}
+	deploymentsMutex.Lock()
+	defer deploymentsMutex.Unlock()
+	deployments[deployment.Network] = deployment
+	return nil
+}
+
+// Get the deployment registered for the given network.
+func GetDeployment(network string) (Deployment, error) {
+	deploymentsMutex.Lock()
+	defer deploymentsMutex.Unlock()
+	deployment, ok := deployments[network]
+	if !ok {
+		return Deployment{}, fmt.Errorf("unknown network: %v", network)
+	}
+	return deployment, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestLoadDeploymentJSON(t *testing.T) {
+	data := []byte(`{
+		"name": "sepolia",
+		"chainId": "11155111",
+		"contracts": {
+			"Authority": {"address": "0x0000000000000000000000000000000000000001", "abi": []},
+			"CartesiDAppFactory": {"address": "0x7122cd1221C20892234186facfE8615e6743Ab02", "abi": []},
+			"DAppAddressRelay": {"address": "0xF5DE34d6BbC0446E2a45719E718efEbaaE179daE", "abi": []},
+			"ERC1155BatchPortal": {"address": "0xedB53860A6B52bbb7561Ad596416ee9965B055Aa", "abi": []},
+			"ERC1155SinglePortal": {"address": "0x7CFB0193Ca87eB6e48056885E026552c3A941FC4", "abi": []},
+			"ERC20Portal": {"address": "0x9C21AEb2093C32DDbC53eEF24B873BDCd1aDa1DB", "abi": []},
+			"ERC721Portal": {"address": "0x237F8DD094C0e47f4236f12b4Fa01d6Dae89fb87", "abi": []},
+			"EtherPortal": {"address": "0xFfdbe43d4c855BF7e0f105c400A50857f53AB044", "abi": []},
+			"InputBox": {"address": "0x59b22D57D4f067708AB0c00552767405926dc768", "abi": []}
+		}
+	}`)
+	deployment, err := LoadDeploymentJSON(data)
+	if err != nil {
+		t.Fatalf("failed to load deployment: %v", err)
+	}
+	expected := LocalhostDeployment()
+	expected.Network = "sepolia"
+	expected.ChainID = 11155111
+	expected.SunodoToken = common.Address{}
+	if deployment != expected {
+		t.Fatalf("wrong deployment: %+v", deployment)
+	}
+}
+
+func TestLoadDeploymentYAML(t *testing.T) {
+	data := []byte(`
+network: testnet
+chainId: 1234
+contracts:
+  InputBox: "0x59b22D57D4f067708AB0c00552767405926dc768"
+`)
+	_, err := LoadDeploymentYAML(data)
+	expectedErr := "missing address of [CartesiDAppFactory DAppAddressRelay ERC1155BatchPortal " +
+		"ERC1155SinglePortal ERC20Portal ERC721Portal EtherPortal]"
+	if err == nil || err.Error() != expectedErr {
+		t.Fatalf("wrong error: %v", err)
+	}
+
+	data = []byte(`
+network: testnet
+chainId: 1234
+inputBoxBlock: 12
+contracts:
+  CartesiDAppFactory: "0x0000000000000000000000000000000000000001"
+  DAppAddressRelay: "0x0000000000000000000000000000000000000002"
+  ERC1155BatchPortal: "0x0000000000000000000000000000000000000003"
+  ERC1155SinglePortal: "0x0000000000000000000000000000000000000004"
+  ERC20Portal: "0x0000000000000000000000000000000000000005"
+  ERC721Portal: "0x0000000000000000000000000000000000000006"
+  EtherPortal: "0x0000000000000000000000000000000000000007"
+  InputBox: "0x0000000000000000000000000000000000000008"
+  SunodoToken: "0x0000000000000000000000000000000000000009"
+`)
+	path := filepath.Join(t.TempDir(), "testnet.yaml")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	deployment, err := LoadDeploymentFile(path)
+	if err != nil {
+		t.Fatalf("failed to load deployment: %v", err)
+	}
+	if deployment.Network != "testnet" || deployment.ChainID != 1234 {
+		t.Fatalf("wrong network: %v %v", deployment.Network, deployment.ChainID)
+	}
+	if deployment.InputBoxBlock != 12 {
+		t.Fatalf("wrong input box block: %v", deployment.InputBoxBlock)
+	}
+	if deployment.InputBox != common.HexToAddress("0x0000000000000000000000000000000000000008") {
+		t.Fatalf("wrong input box: %v", deployment.InputBox)
+	}
+	if deployment.SunodoToken != common.HexToAddress("0x0000000000000000000000000000000000000009") {
+		t.Fatalf("wrong sunodo token: %v", deployment.SunodoToken)
+	}
+}
+
+func TestRegisterDeployment(t *testing.T) {
+	localhost, err := GetDeployment("localhost")
+	if err != nil {
+		t.Fatalf("failed to get localhost: %v", err)
+	}
+	if localhost != LocalhostDeployment() {
+		t.Fatalf("wrong localhost deployment")
+	}
+
+	if _, err := GetDeployment("eggroll-test"); err == nil {
+		t.Fatalf("expected error; got nil")
+	}
+	deployment := LocalhostDeployment()
+	deployment.Network = "eggroll-test"
+	if err := RegisterDeployment(deployment); err != nil {
+		t.Fatalf("failed to register: %v", err)
+	}
+	registered, err := GetDeployment("eggroll-test")
+	if err != nil {
+		t.Fatalf("failed to get deployment: %v", err)
+	}
+	if registered != deployment {
+		t.Fatalf("wrong deployment: %+v", registered)
+	}
+
+	deployment.InputBox = common.Address{}
+	if err := RegisterDeployment(deployment); err == nil {
+		t.Fatalf("expected error; got nil")
+	}
+}
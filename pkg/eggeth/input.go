@@ -0,0 +1,299 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+	"github.com/gligneul/eggroll/pkg/eggwallets"
+)
+
+// Input added to the InputBox contract, as seen in the base layer.
+type Input struct {
+
+	// Index of the input in the DApp.
+	Index int
+
+	// Account that called the InputBox; for deposits, this is the portal address.
+	Sender common.Address
+
+	// Block that added the input.
+	BlockNumber uint64
+
+	// Transaction that added the input.
+	TxHash common.Hash
+
+	// Raw input sent to the DApp.
+	Payload []byte
+
+	// Deposit decoded from the portal input; nil if the input didn't come from a portal.
+	Deposit eggwallets.Deposit
+
+	// Message decoded from the DApp payload with eggtypes.Decode; nil if there is no
+	// schema for the payload. For deposits, this is the payload after the deposit data.
+	Message any
+}
+
+// Options to watch the inputs.
+type WatchInputsOpts struct {
+
+	// Interval between requests when waiting for new blocks.
+	PollInterval time.Duration
+
+	// Number of consecutive failed requests before the watcher stops.
+	MaxRetries int
+
+	// Max number of blocks in each log request.
+	BlockRange uint64
+}
+
+// Return the default values for the options.
+func MakeWatchInputsOpts() WatchInputsOpts {
+	return WatchInputsOpts{
+		PollInterval: time.Second,
+		MaxRetries:   5,
+		BlockRange:   10_000,
+	}
+}
+
+// Subscription to the inputs added to the InputBox.
+type InputSubscription struct {
+	inputs chan *Input
+	err    error
+}
+
+// Get the channel that yields each input in order.
+// The channel is closed when the subscription stops.
+func (s *InputSubscription) Inputs() <-chan *Input {
+	return s.inputs
+}
+
+// Return the error that stopped the subscription.
+// This should be called after the inputs channel is closed.
+func (s *InputSubscription) Err() error {
+	return s.err
+}
+
+// Max number of blocks in each log request when looking for an input.
+const getInputBlockRange = 10_000
+
+// Get the input of the client's DApp with the given index.
+// Look for the input from the latest block back to the block of the InputBox deployment,
+// requesting at most getInputBlockRange blocks at a time.
+func (c *ETHClient) GetInput(ctx context.Context, index int) (*Input, error) {
+	head, err := c.client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block number: %v", err)
+	}
+	startBlock := c.deployment.InputBoxBlock
+	for end := head; end >= startBlock; end -= getInputBlockRange {
+		start := startBlock
+		if end-startBlock >= getInputBlockRange {
+			start = end - getInputBlockRange + 1
+		}
+		input, err := c.findInput(ctx, index, start, end)
+		if err != nil || input != nil {
+			return input, err
+		}
+		if start == startBlock {
+			break
+		}
+	}
+	return nil, fmt.Errorf("input %v not found", index)
+}
+
+// Look for the input between the given blocks; return nil if not found.
+func (c *ETHClient) findInput(
+	ctx context.Context, index int, fromBlock uint64, toBlock uint64) (*Input, error) {
+
+	opts := &bind.FilterOpts{
+		Start:   fromBlock,
+		End:     &toBlock,
+		Context: ctx,
+	}
+	it, err := c.inputBox.FilterInputAdded(
+		opts, []common.Address{c.dappAddress}, []*big.Int{big.NewInt(int64(index))})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter input added: %v", err)
+	}
+	defer it.Close()
+	if !it.Next() {
+		if it.Error() != nil {
+			return nil, fmt.Errorf("failed to read input added: %v", it.Error())
+		}
+		return nil, nil
+	}
+	return c.decodeInput(it.Event), nil
+}
+
+// Watch the inputs of the given DApp, starting from the given block.
+// The subscription stops when the context is canceled.
+func (c *ETHClient) WatchInputs(
+	ctx context.Context, dapp common.Address, fromBlock uint64) *InputSubscription {
+
+	return c.WatchInputsWithOpts(ctx, dapp, fromBlock, MakeWatchInputsOpts())
+}
+
+// Watch the inputs of the given DApp with the given options.
+// The subscription stops when the context is canceled or when the requests to the
+// Ethereum node fail more than opts.MaxRetries times in a row.
+func (c *ETHClient) WatchInputsWithOpts(ctx context.Context, dapp common.Address,
+	fromBlock uint64, opts WatchInputsOpts) *InputSubscription {
+
+	sub := &InputSubscription{
+		inputs: make(chan *Input),
+	}
+	go func() {
+		defer close(sub.inputs)
+		sub.err = c.watchInputs(ctx, dapp, fromBlock, opts, sub.inputs)
+	}()
+	return sub
+}
+
+// Send the inputs to the channel until an error happens.
+func (c *ETHClient) watchInputs(ctx context.Context, dapp common.Address, nextBlock uint64,
+	opts WatchInputsOpts, inputs chan<- *Input) error {
+
+	// index of the next input to be sent; used to skip the inputs already sent
+	// when retrying a range that failed midway
+	nextIndex := 0
+	failures := 0
+	backoff := opts.PollInterval
+	for {
+		lastBlock, err := c.client.BlockNumber(ctx)
+		if err == nil && lastBlock >= nextBlock {
+			lastBlock = min(lastBlock, nextBlock+opts.BlockRange-1)
+			err = c.filterInputs(ctx, dapp, nextBlock, lastBlock, &nextIndex, inputs)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			failures++
+			if failures > opts.MaxRetries {
+				return err
+			}
+			// wait longer after each failure
+			backoff *= 2
+			goto wait
+		}
+		failures = 0
+		backoff = opts.PollInterval
+
+		if lastBlock >= nextBlock {
+			nextBlock = lastBlock + 1
+			continue
+		}
+
+	wait:
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+			continue
+		}
+	}
+}
+
+// Send the inputs added between the given blocks to the channel.
+// Skip the inputs before nextIndex and update it after sending each input.
+func (c *ETHClient) filterInputs(ctx context.Context, dapp common.Address,
+	fromBlock uint64, toBlock uint64, nextIndex *int, inputs chan<- *Input) error {
+
+	opts := &bind.FilterOpts{
+		Start:   fromBlock,
+		End:     &toBlock,
+		Context: ctx,
+	}
+	it, err := c.inputBox.FilterInputAdded(opts, []common.Address{dapp}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to filter input added: %v", err)
+	}
+	defer it.Close()
+	for it.Next() {
+		input := c.decodeInput(it.Event)
+		if input.Index < *nextIndex {
+			continue
+		}
+		select {
+		case inputs <- input:
+			*nextIndex = input.Index + 1
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if it.Error() != nil {
+		return fmt.Errorf("failed to read input added: %v", it.Error())
+	}
+	return nil
+}
+
+// Decode the input added event.
+func (c *ETHClient) decodeInput(event *bindings.InputBoxInputAdded) *Input {
+	input := &Input{
+		// We assume that int will fit all dapp inputs
+		Index:       int(event.InputIndex.Int64()),
+		Sender:      event.Sender,
+		BlockNumber: event.Raw.BlockNumber,
+		TxHash:      event.Raw.TxHash,
+		Payload:     event.Input,
+	}
+	payload := event.Input
+	if decode := c.portalDecoder(event.Sender); decode != nil {
+		deposit, depositPayload, err := decode(payload)
+		if err != nil {
+			return input
+		}
+		input.Deposit = deposit
+		payload = depositPayload
+	} else if event.Sender == c.deployment.DAppAddressRelay {
+		return input
+	}
+	if message, err := eggtypes.Decode(payload); err == nil {
+		input.Message = message
+	}
+	return input
+}
+
+// Function that decodes the deposit of a portal and returns the remaining payload.
+type depositDecoder func(payload []byte) (eggwallets.Deposit, []byte, error)
+
+// Wrap the decoder of a concrete deposit type.
+func makeDepositDecoder[T eggwallets.Deposit](
+	decode func([]byte) (T, []byte, error)) depositDecoder {
+
+	return func(payload []byte) (eggwallets.Deposit, []byte, error) {
+		deposit, payload, err := decode(payload)
+		if err != nil {
+			return nil, nil, err
+		}
+		return deposit, payload, nil
+	}
+}
+
+// Return the decoder of the deposits of the portal.
+// Return nil if the address is not a portal.
+func (c *ETHClient) portalDecoder(address common.Address) depositDecoder {
+	switch address {
+	case c.deployment.EtherPortal:
+		return makeDepositDecoder(eggwallets.DecodeEtherDeposit)
+	case c.deployment.ERC20Portal:
+		return makeDepositDecoder(eggwallets.DecodeERC20Deposit)
+	case c.deployment.ERC721Portal:
+		return makeDepositDecoder(eggwallets.DecodeERC721Deposit)
+	case c.deployment.ERC1155SinglePortal:
+		return makeDepositDecoder(eggwallets.DecodeERC1155SingleDeposit)
+	case c.deployment.ERC1155BatchPortal:
+		return makeDepositDecoder(eggwallets.DecodeERC1155BatchDeposit)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+	"github.com/gligneul/eggroll/pkg/eggwallets"
+)
+
+// Create the InputAdded log emitted by the InputBox.
+func makeInputAddedLog(t *testing.T, dapp common.Address, index int64,
+	sender common.Address, input []byte, blockNumber uint64) *types.Log {
+
+	abi, err := bindings.InputBoxMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := abi.Events["InputAdded"]
+	data, err := event.Inputs.NonIndexed().Pack(sender, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &types.Log{
+		Address: LocalhostDeployment().InputBox,
+		Topics: []common.Hash{
+			event.ID,
+			common.BytesToHash(dapp[:]),
+			common.BigToHash(big.NewInt(index)),
+		},
+		Data:        data,
+		BlockNumber: blockNumber,
+		TxHash:      common.BigToHash(big.NewInt(index + 1)),
+		BlockHash:   common.BigToHash(big.NewInt(int64(blockNumber))),
+	}
+}
+
+func TestWatchInputs(t *testing.T) {
+	dapp := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	sender := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	deployment := LocalhostDeployment()
+	etherDeposit := append(sender.Bytes(), common.BigToHash(big.NewInt(100)).Bytes()...)
+	etherDeposit = append(etherDeposit, eggtypes.EncodeLog("deposit")...)
+	logs := []*types.Log{
+		makeInputAddedLog(t, dapp, 0, sender, eggtypes.EncodeLog("eggroll"), 1),
+		makeInputAddedLog(t, dapp, 1, deployment.EtherPortal, etherDeposit, 2),
+		makeInputAddedLog(t, dapp, 2, deployment.DAppAddressRelay, dapp.Bytes(), 3),
+		makeInputAddedLog(t, dapp, 3, sender, []byte("raw"), 3),
+	}
+	ethClient := setupStubNode(t, map[string]any{
+		"eth_blockNumber": "0x3",
+		"eth_getLogs":     logs,
+	})
+	client, err := newETHClient(ethClient, dapp, deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	opts := MakeWatchInputsOpts()
+	opts.PollInterval = 10 * time.Millisecond
+	sub := client.WatchInputsWithOpts(ctx, dapp, 0, opts)
+	var inputs []*Input
+	for input := range sub.Inputs() {
+		inputs = append(inputs, input)
+		if len(inputs) == len(logs) {
+			cancel()
+		}
+	}
+	if sub.Err() != context.Canceled {
+		t.Fatalf("unexpected error: %v", sub.Err())
+	}
+	if len(inputs) != len(logs) {
+		t.Fatalf("wrong number of inputs: %v", len(inputs))
+	}
+
+	if inputs[0].Index != 0 || inputs[0].Sender != sender || inputs[0].BlockNumber != 1 {
+		t.Fatalf("wrong input: %+v", inputs[0])
+	}
+	if !reflect.DeepEqual(inputs[0].Message, eggtypes.Log{Message: "eggroll"}) {
+		t.Fatalf("wrong message: %v", inputs[0].Message)
+	}
+
+	expectedDeposit := &eggwallets.EtherDeposit{Sender: sender, Value: big.NewInt(100)}
+	if !reflect.DeepEqual(inputs[1].Deposit, expectedDeposit) {
+		t.Fatalf("wrong deposit: %v", inputs[1].Deposit)
+	}
+	if !reflect.DeepEqual(inputs[1].Message, eggtypes.Log{Message: "deposit"}) {
+		t.Fatalf("wrong message: %v", inputs[1].Message)
+	}
+
+	if inputs[2].Deposit != nil || inputs[2].Message != nil {
+		t.Fatalf("expected no deposit and message: %+v", inputs[2])
+	}
+
+	if inputs[3].Message != nil || !bytes.Equal(inputs[3].Payload, []byte("raw")) {
+		t.Fatalf("wrong input: %+v", inputs[3])
+	}
+}
+
+func TestWatchInputsRetryMidRange(t *testing.T) {
+	dapp := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	sender := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	deployment := LocalhostDeployment()
+	logs := []*types.Log{
+		makeInputAddedLog(t, dapp, 0, sender, []byte("first"), 1),
+		makeInputAddedLog(t, dapp, 1, sender, []byte("second"), 2),
+		makeInputAddedLog(t, dapp, 2, sender, []byte("third"), 3),
+	}
+	// the first request returns a malformed log after the first input
+	malformed := *logs[1]
+	malformed.Data = []byte{0xde, 0xad}
+	var calls atomic.Int32
+	ethClient := setupStubNode(t, map[string]any{
+		"eth_blockNumber": "0x3",
+		"eth_getLogs": func() any {
+			if calls.Add(1) == 1 {
+				return []*types.Log{logs[0], &malformed}
+			}
+			return logs
+		},
+	})
+	client, err := newETHClient(ethClient, dapp, deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	opts := MakeWatchInputsOpts()
+	opts.PollInterval = 10 * time.Millisecond
+	sub := client.WatchInputsWithOpts(ctx, dapp, 0, opts)
+	var inputs []*Input
+	for input := range sub.Inputs() {
+		inputs = append(inputs, input)
+		if input.Index == len(logs)-1 {
+			cancel()
+		}
+	}
+	if sub.Err() != context.Canceled {
+		t.Fatalf("unexpected error: %v", sub.Err())
+	}
+	if calls.Load() < 2 {
+		t.Fatalf("expected a retry; got %v calls", calls.Load())
+	}
+	if len(inputs) != len(logs) {
+		t.Fatalf("wrong number of inputs: %v", len(inputs))
+	}
+	for i, input := range inputs {
+		if input.Index != i {
+			t.Fatalf("wrong input at %v: %+v", i, input)
+		}
+	}
+}
+
+func TestGetInput(t *testing.T) {
+	dapp := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	sender := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	var ranges [][2]uint64
+	ethClient := setupStubNode(t, map[string]any{
+		"eth_blockNumber": "0x61a8", // 25000
+		"eth_getLogs": func(params []json.RawMessage) any {
+			var query struct {
+				FromBlock hexutil.Uint64 `json:"fromBlock"`
+				ToBlock   hexutil.Uint64 `json:"toBlock"`
+			}
+			if err := json.Unmarshal(params[0], &query); err != nil {
+				t.Errorf("failed to decode query: %v", err)
+			}
+			ranges = append(ranges, [2]uint64{uint64(query.FromBlock), uint64(query.ToBlock)})
+			if query.FromBlock <= 42 && 42 <= query.ToBlock {
+				return []*types.Log{makeInputAddedLog(t, dapp, 7, sender, []byte("raw"), 42)}
+			}
+			return []*types.Log{}
+		},
+	})
+	deployment := LocalhostDeployment()
+	deployment.InputBoxBlock = 40
+	client, err := newETHClient(ethClient, dapp, deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, err := client.GetInput(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("failed to get input: %v", err)
+	}
+	if input.Index != 7 || input.Sender != sender || input.BlockNumber != 42 {
+		t.Fatalf("wrong input: %+v", input)
+	}
+	expectedRanges := [][2]uint64{{15001, 25000}, {5001, 15000}, {40, 5000}}
+	if !reflect.DeepEqual(ranges, expectedRanges) {
+		t.Fatalf("wrong ranges: %v", ranges)
+	}
+
+	ethClient = setupStubNode(t, map[string]any{
+		"eth_blockNumber": "0x10",
+		"eth_getLogs":     []*types.Log{},
+	})
+	client, _ = newETHClient(ethClient, dapp, LocalhostDeployment())
+	_, err = client.GetInput(context.Background(), 8)
+	if err == nil || err.Error() != "input 8 not found" {
+		t.Fatalf("wrong error: %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Create a signer by using a go-ethereum JSON keystore file.
+type KeystoreSigner struct {
+	key     *keystore.Key
+	chainId *big.Int
+}
+
+// Create a new keystore signer by decrypting the given keystore file.
+func NewKeystoreSigner(path string, passphrase string, chainId *big.Int) (*KeystoreSigner, error) {
+	keyJson, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore: %v", err)
+	}
+	key, err := keystore.DecryptKey(keyJson, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %v", err)
+	}
+	signer := &KeystoreSigner{
+		key:     key,
+		chainId: chainId,
+	}
+	return signer, nil
+}
+
+func (s *KeystoreSigner) MakeTransactor() (*bind.TransactOpts, error) {
+	return bind.NewKeyedTransactorWithChainID(s.key.PrivateKey, s.chainId)
+}
+
+func (s *KeystoreSigner) Account() common.Address {
+	return s.key.Address
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -15,21 +16,57 @@ import (
 	"github.com/tyler-smith/go-bip39"
 )
 
+// Default BIP44 derivation path for Ethereum accounts, without the account index.
+const DefaultDerivationPath = "m/44'/60'/0'/0"
+
+// Options for the mnemonic signer.
+type MnemonicOpts struct {
+
+	// Derivation path without the account index, which is appended to it.
+	DerivationPath string
+
+	// Optional BIP39 passphrase.
+	Passphrase string
+}
+
+// Create the default options for the mnemonic signer.
+func MakeMnemonicOpts() MnemonicOpts {
+	return MnemonicOpts{
+		DerivationPath: DefaultDerivationPath,
+	}
+}
+
 // Create a signer by using a mnemonic.
 type MnemonicSigner struct {
 	privateKey *ecdsa.PrivateKey
 	chainId    *big.Int
 	mnemonic   string
+	basePath   accounts.DerivationPath
+	passphrase string
 }
 
 // Create a new mnemonic signer.
 func NewMnemonicSigner(mnemonic string, accountIndex uint32, chainId *big.Int) (
 	s *MnemonicSigner, err error) {
 
+	return NewMnemonicSignerWithOpts(mnemonic, accountIndex, chainId, MakeMnemonicOpts())
+}
+
+// Create a new mnemonic signer with the given options.
+func NewMnemonicSignerWithOpts(
+	mnemonic string, accountIndex uint32, chainId *big.Int, opts MnemonicOpts) (
+	s *MnemonicSigner, err error) {
+
+	basePath, err := accounts.ParseDerivationPath(opts.DerivationPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path: %v", err)
+	}
 	signer := &MnemonicSigner{
 		privateKey: nil,
 		chainId:    chainId,
 		mnemonic:   mnemonic,
+		basePath:   basePath,
+		passphrase: opts.Passphrase,
 	}
 	if err = signer.SetAccount(accountIndex); err != nil {
 		return nil, err
@@ -38,7 +75,9 @@ func NewMnemonicSigner(mnemonic string, accountIndex uint32, chainId *big.Int) (
 }
 
 func (s *MnemonicSigner) SetAccount(accountIndex uint32) error {
-	privateKey, err := mnemonicToPrivateKey(s.mnemonic, accountIndex)
+	path := append(accounts.DerivationPath{}, s.basePath...)
+	path = append(path, accountIndex)
+	privateKey, err := mnemonicPathToPrivateKey(s.mnemonic, s.passphrase, path)
 	if err != nil {
 		return err
 	}
@@ -59,24 +98,31 @@ func (s *MnemonicSigner) Account() common.Address {
 // Create the private key from mnemonic and account index based on the BIP44 standard.
 // For more info on BIP44, see https://github.com/bitcoin/bips/blob/master/bip-0044.mediawiki
 func mnemonicToPrivateKey(mnemonic string, accountIndex uint32) (*ecdsa.PrivateKey, error) {
-	seed := bip39.NewSeed(mnemonic, "")
-
-	masterKey, err := bip32.NewMasterKey(seed)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate master key: %v", err)
-	}
-
 	// get key at path m/44'/60'/0'/0/account
 	const hardenedKeyStart uint32 = 0x80000000
-	levels := []uint32{
+	path := accounts.DerivationPath{
 		hardenedKeyStart + 44,
 		hardenedKeyStart + 60,
 		hardenedKeyStart + 0,
 		0,
 		accountIndex,
 	}
+	return mnemonicPathToPrivateKey(mnemonic, "", path)
+}
+
+// Create the private key from mnemonic and passphrase at the given derivation path.
+func mnemonicPathToPrivateKey(mnemonic string, passphrase string, path accounts.DerivationPath) (
+	*ecdsa.PrivateKey, error) {
+
+	seed := bip39.NewSeed(mnemonic, passphrase)
+
+	masterKey, err := bip32.NewMasterKey(seed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate master key: %v", err)
+	}
+
 	key := masterKey
-	for i, level := range levels {
+	for i, level := range path {
 		key, err = key.NewChildKey(level)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get child %v: %v", i, err)
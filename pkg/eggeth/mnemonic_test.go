@@ -5,8 +5,10 @@ package eggeth
 
 import (
 	"crypto/ecdsa"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -30,3 +32,47 @@ func TestMnemonicToPrivateKey(t *testing.T) {
 	expected, _ = crypto.HexToECDSA("59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d")
 	testKey(t, mnemonic, 1, expected)
 }
+
+func TestMnemonicSignerOpts(t *testing.T) {
+	mnemonic := "test test test test test test test test test test test junk"
+	chainId := big.NewInt(31337)
+
+	// m/44'/60'/0'/0/1 is equivalent to the default path with the account 1
+	opts := MakeMnemonicOpts()
+	opts.DerivationPath = "m/44'/60'/0'/0"
+	signer, err := NewMnemonicSignerWithOpts(mnemonic, 1, chainId, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	if signer.Account() != expected {
+		t.Fatalf("wrong account: %v", signer.Account())
+	}
+
+	// a different path yields a different account
+	opts.DerivationPath = "m/44'/60'/1'/0"
+	signer, err = NewMnemonicSignerWithOpts(mnemonic, 1, chainId, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if signer.Account() == expected {
+		t.Fatalf("expected different account")
+	}
+
+	// the passphrase changes the seed
+	opts = MakeMnemonicOpts()
+	opts.Passphrase = "secret"
+	signer, err = NewMnemonicSignerWithOpts(mnemonic, 1, chainId, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if signer.Account() == expected {
+		t.Fatalf("expected different account")
+	}
+
+	opts.DerivationPath = "invalid"
+	_, err = NewMnemonicSignerWithOpts(mnemonic, 1, chainId, opts)
+	if err == nil {
+		t.Fatalf("expected error; got nil")
+	}
+}
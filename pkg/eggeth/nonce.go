@@ -0,0 +1,64 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Keep track of the next nonce of each account, so concurrent transactions from the
+// same account don't reuse nonces.
+type nonceManager struct {
+	mutex  sync.Mutex
+	nonces map[common.Address]uint64
+}
+
+func newNonceManager() *nonceManager {
+	return &nonceManager{
+		nonces: make(map[common.Address]uint64),
+	}
+}
+
+// Reserve the next nonce for the account.
+// Fetch the pending nonce from the node the first time, or after a reset.
+func (m *nonceManager) next(
+	ctx context.Context, client *ethclient.Client, account common.Address) (uint64, error) {
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	nonce, ok := m.nonces[account]
+	if !ok {
+		var err error
+		nonce, err = client.PendingNonceAt(ctx, account)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get nonce: %v", err)
+		}
+	}
+	m.nonces[account] = nonce + 1
+	return nonce, nil
+}
+
+// Forget the nonce of the account, so the next one is fetched from the node.
+func (m *nonceManager) reset(account common.Address) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.nonces, account)
+}
+
+// Check whether the node rejected the transaction because of its nonce.
+func isNonceError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") || strings.Contains(msg, "nonce too high")
+}
+
+// Check whether the node rejected the transaction because it already has it in the pool.
+func isAlreadyKnown(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "already known")
+}
@@ -0,0 +1,150 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestNonceManager(t *testing.T) {
+	pendingNonce := uint64(5)
+	client := setupStubNode(t, map[string]any{
+		"eth_getTransactionCount": func() any {
+			return hexutil.Uint64(pendingNonce)
+		},
+	})
+	ctx := context.Background()
+	nonces := newNonceManager()
+	for i := uint64(5); i < 8; i++ {
+		nonce, err := nonces.next(ctx, client, testAccount)
+		if err != nil {
+			t.Fatalf("failed to get nonce: %v", err)
+		}
+		if nonce != i {
+			t.Fatalf("wrong nonce: %v; expected %v", nonce, i)
+		}
+	}
+
+	pendingNonce = 10
+	nonces.reset(testAccount)
+	nonce, err := nonces.next(ctx, client, testAccount)
+	if err != nil {
+		t.Fatalf("failed to get nonce: %v", err)
+	}
+	if nonce != 10 {
+		t.Fatalf("wrong nonce: %v", nonce)
+	}
+}
+
+func TestSubmitTransactionNonceTooLow(t *testing.T) {
+	pendingNonce := uint64(0)
+	var sentNonces []uint64
+	client := setupStubNode(t, map[string]any{
+		"eth_getTransactionCount": func() any {
+			return hexutil.Uint64(pendingNonce)
+		},
+		"eth_getBlockByNumber": makeStubHeader(nil),
+		"eth_gasPrice":         "0x3e8",
+		"eth_sendRawTransaction": func() any {
+			// another process used the nonce 0
+			if len(sentNonces) == 1 {
+				pendingNonce = 1
+				return fmt.Errorf("nonce too low")
+			}
+			return common.Hash{}
+		},
+	})
+	signer, _ := NewPrivateKeySignerFromHex(testPrivateKey, big.NewInt(31337))
+	opts := MakeTxOptions()
+	opts.GasLimit = 21000
+	to := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	contract := bind.NewBoundContract(to, abi.ABI{}, client, client, client)
+	tx, err := _submitTransaction(context.Background(), client, newNonceManager(), signer,
+		big.NewInt(1), opts, func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+			sentNonces = append(sentNonces, txOpts.Nonce.Uint64())
+			return contract.RawTransact(txOpts, nil)
+		})
+	if err != nil {
+		t.Fatalf("failed to submit: %v", err)
+	}
+	if tx.Nonce() != 1 {
+		t.Fatalf("wrong nonce: %v", tx.Nonce())
+	}
+	if !reflect.DeepEqual(sentNonces, []uint64{0, 1}) {
+		t.Fatalf("wrong sent nonces: %v", sentNonces)
+	}
+}
+
+func TestIsNonceError(t *testing.T) {
+	if !isNonceError(fmt.Errorf("Nonce too low: next nonce 10")) {
+		t.Fatalf("expected nonce error")
+	}
+	if !isNonceError(fmt.Errorf("nonce too high")) {
+		t.Fatalf("expected nonce error")
+	}
+	for _, msg := range []string{
+		"insufficient funds for gas * price + value",
+		"already known",
+		"replacement transaction underpriced",
+	} {
+		if isNonceError(fmt.Errorf(msg)) {
+			t.Fatalf("expected other error: %v", msg)
+		}
+	}
+}
+
+func TestSubmitTransactionAlreadyKnown(t *testing.T) {
+	var sentTxs []common.Hash
+	client := setupStubNode(t, map[string]any{
+		"eth_getTransactionCount": "0x0",
+		"eth_getBlockByNumber":    makeStubHeader(nil),
+		"eth_gasPrice":            "0x3e8",
+		"eth_sendRawTransaction": func(params []json.RawMessage) any {
+			var data hexutil.Bytes
+			if err := json.Unmarshal(params[0], &data); err != nil {
+				return err
+			}
+			var tx types.Transaction
+			if err := tx.UnmarshalBinary(data); err != nil {
+				return err
+			}
+			sentTxs = append(sentTxs, tx.Hash())
+			// the node received the transaction before, such as in a retried request
+			return fmt.Errorf("already known")
+		},
+	})
+	signer, _ := NewPrivateKeySignerFromHex(testPrivateKey, big.NewInt(31337))
+	opts := MakeTxOptions()
+	opts.GasLimit = 21000
+	to := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	contract := bind.NewBoundContract(to, abi.ABI{}, client, client, client)
+	nonces := newNonceManager()
+	tx, err := _submitTransaction(context.Background(), client, nonces, signer,
+		big.NewInt(1), opts, func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+			return contract.RawTransact(txOpts, nil)
+		})
+	if err != nil {
+		t.Fatalf("failed to submit: %v", err)
+	}
+	if len(sentTxs) != 1 || tx.Hash() != sentTxs[0] || tx.Nonce() != 0 {
+		t.Fatalf("wrong transactions: %v %v", tx.Hash(), sentTxs)
+	}
+
+	// the nonce stays reserved for the known transaction
+	nonce, err := nonces.next(context.Background(), client, signer.Account())
+	if err != nil || nonce != 1 {
+		t.Fatalf("wrong next nonce: %v %v", nonce, err)
+	}
+}
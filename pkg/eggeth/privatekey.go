@@ -0,0 +1,59 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Create a signer by using a raw private key.
+type PrivateKeySigner struct {
+	privateKey *ecdsa.PrivateKey
+	chainId    *big.Int
+}
+
+// Create a new private key signer.
+func NewPrivateKeySigner(privateKey *ecdsa.PrivateKey, chainId *big.Int) *PrivateKeySigner {
+	return &PrivateKeySigner{
+		privateKey: privateKey,
+		chainId:    chainId,
+	}
+}
+
+// Create a new private key signer from the hex-encoded key.
+// The key may have the 0x prefix.
+func NewPrivateKeySignerFromHex(hexKey string, chainId *big.Int) (*PrivateKeySigner, error) {
+	hexKey = strings.TrimPrefix(strings.TrimSpace(hexKey), "0x")
+	privateKey, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %v", err)
+	}
+	return NewPrivateKeySigner(privateKey, chainId), nil
+}
+
+// Create a new private key signer from the hex-encoded key in the given
+// environment variable.
+func NewPrivateKeySignerFromEnv(name string, chainId *big.Int) (*PrivateKeySigner, error) {
+	hexKey, ok := os.LookupEnv(name)
+	if !ok {
+		return nil, fmt.Errorf("environment variable %v not set", name)
+	}
+	return NewPrivateKeySignerFromHex(hexKey, chainId)
+}
+
+func (s *PrivateKeySigner) MakeTransactor() (*bind.TransactOpts, error) {
+	return bind.NewKeyedTransactorWithChainID(s.privateKey, s.chainId)
+}
+
+func (s *PrivateKeySigner) Account() common.Address {
+	return crypto.PubkeyToAddress(s.privateKey.PublicKey)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Create a signer that sends the transactions to an external JSON-RPC signer,
+// such as Clef or Web3Signer, with the eth_signTransaction method.
+type RemoteSigner struct {
+	client  *rpc.Client
+	account common.Address
+	chainId *big.Int
+}
+
+// Create a new remote signer for the given account.
+func NewRemoteSigner(endpoint string, account common.Address, chainId *big.Int) (
+	*RemoteSigner, error) {
+
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer: %v", err)
+	}
+	signer := &RemoteSigner{
+		client:  client,
+		account: account,
+		chainId: chainId,
+	}
+	return signer, nil
+}
+
+func (s *RemoteSigner) MakeTransactor() (*bind.TransactOpts, error) {
+	txOpts := &bind.TransactOpts{
+		From: s.account,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != s.account {
+				return nil, bind.ErrNotAuthorized
+			}
+			return s.signTransaction(context.Background(), tx)
+		},
+		Context: context.Background(),
+	}
+	return txOpts, nil
+}
+
+func (s *RemoteSigner) Account() common.Address {
+	return s.account
+}
+
+// Arguments of the eth_signTransaction method.
+type remoteTransactionArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+// Send the transaction to the remote signer and return the signed transaction.
+func (s *RemoteSigner) signTransaction(ctx context.Context, tx *types.Transaction) (
+	*types.Transaction, error) {
+
+	args := remoteTransactionArgs{
+		From:    s.account,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(s.chainId),
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	var result json.RawMessage
+	err := s.client.CallContext(ctx, &result, "eth_signTransaction", args)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer failed: %v", err)
+	}
+	raw, err := decodeSignResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode signed transaction: %v", err)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(s.chainId), signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover signer: %v", err)
+	}
+	if sender != s.account {
+		return nil, fmt.Errorf("remote signer signed with wrong account: %v", sender)
+	}
+	return signedTx, nil
+}
+
+// Decode the result of eth_signTransaction.
+// Geth-based signers return an object with the raw transaction, while other
+// signers return the raw transaction directly.
+func decodeSignResult(result json.RawMessage) ([]byte, error) {
+	var object struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	if err := json.Unmarshal(result, &object); err == nil && len(object.Raw) != 0 {
+		return object.Raw, nil
+	}
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		return nil, fmt.Errorf("invalid remote signer result: %v", err)
+	}
+	return raw, nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
+)
+
+// Error returned when a transaction or a call reverts.
+type RevertError struct {
+
+	// Hash of the failed transaction; zero if the call reverted before sending the
+	// transaction, for instance, when estimating the gas.
+	TxHash common.Hash
+
+	// Raw revert data.
+	Data []byte
+
+	// Selector of the error; zero if the revert data is empty.
+	Selector [4]byte
+
+	// Name of the error, such as Error, Panic, or the name of a custom error.
+	// Empty if the error is unknown.
+	Name string
+
+	// Decoded arguments of the error.
+	Args []any
+
+	// Output of debug_traceTransaction; only set if TxOptions.TraceOnRevert is true.
+	Trace string
+
+	// Error of debug_traceTransaction when tracing the transaction failed.
+	TraceErr error
+}
+
+func (e *RevertError) Error() string {
+	var reason string
+	switch {
+	case len(e.Data) == 0:
+		reason = "reverted without reason"
+	case e.Name == "Error" && len(e.Args) == 1:
+		reason = fmt.Sprintf("reverted: %v", e.Args[0])
+	case e.Name == "Panic":
+		message, _ := abi.UnpackRevert(e.Data)
+		reason = fmt.Sprintf("panicked: %v", message)
+	case e.Name != "":
+		args := make([]string, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = fmt.Sprint(arg)
+		}
+		reason = fmt.Sprintf("reverted: %v(%v)", e.Name, strings.Join(args, ", "))
+	default:
+		reason = fmt.Sprintf("reverted with unknown error: %v", hexutil.Encode(e.Data))
+	}
+	if e.TraceErr != nil {
+		reason = fmt.Sprintf("%v (failed to trace: %v)", reason, e.TraceErr)
+	}
+	if e.TxHash != (common.Hash{}) {
+		return fmt.Sprintf("transaction %v %v", e.TxHash, reason)
+	}
+	return fmt.Sprintf("execution %v", reason)
+}
+
+// Errors known by eggeth, indexed by selector.
+var (
+	knownErrors     map[[4]byte]abi.Error
+	knownErrorsOnce sync.Once
+)
+
+// Load the errors of the built-in revert reasons and of the known bindings.
+func loadKnownErrors() map[[4]byte]abi.Error {
+	knownErrorsOnce.Do(func() {
+		knownErrors = make(map[[4]byte]abi.Error)
+		stringType, _ := abi.NewType("string", "", nil)
+		uint256Type, _ := abi.NewType("uint256", "", nil)
+		builtins := []abi.Error{
+			abi.NewError("Error", abi.Arguments{{Name: "message", Type: stringType}}),
+			abi.NewError("Panic", abi.Arguments{{Name: "code", Type: uint256Type}}),
+		}
+		for _, abiErr := range builtins {
+			knownErrors[[4]byte(abiErr.ID[:4])] = abiErr
+		}
+		for _, metadata := range []*bind.MetaData{
+			bindings.AuthorityMetaData,
+			bindings.CartesiDAppMetaData,
+			bindings.CartesiDAppFactoryMetaData,
+			bindings.DAppAddressRelayMetaData,
+			bindings.ERC1155BatchPortalMetaData,
+			bindings.ERC1155SinglePortalMetaData,
+			bindings.ERC20PortalMetaData,
+			bindings.ERC721PortalMetaData,
+			bindings.EtherPortalMetaData,
+			bindings.HistoryMetaData,
+			bindings.IConsensusMetaData,
+			bindings.IERC1155MetaData,
+			bindings.IERC20MetaData,
+			bindings.IERC721MetaData,
+			bindings.InputBoxMetaData,
+			bindings.TestERC20MetaData,
+		} {
+			parsed, err := metadata.GetAbi()
+			if err != nil {
+				log.Panicf("failed to parse binding ABI: %v", err)
+			}
+			for _, abiErr := range parsed.Errors {
+				knownErrors[[4]byte(abiErr.ID[:4])] = abiErr
+			}
+		}
+	})
+	return knownErrors
+}
+
+// Decode the revert data using the known errors.
+func decodeRevert(txHash common.Hash, data []byte) *RevertError {
+	revertErr := &RevertError{
+		TxHash: txHash,
+		Data:   data,
+	}
+	if len(data) < 4 {
+		return revertErr
+	}
+	copy(revertErr.Selector[:], data[:4])
+	abiErr, ok := loadKnownErrors()[revertErr.Selector]
+	if !ok {
+		return revertErr
+	}
+	unpacked, err := abiErr.Unpack(data)
+	if err != nil {
+		return revertErr
+	}
+	revertErr.Name = abiErr.Name
+	revertErr.Args, _ = unpacked.([]any)
+	return revertErr
+}
+
+// Get the revert data from the error returned by the node, if any.
+func revertData(err error) ([]byte, bool) {
+	dataErr, ok := err.(rpc.DataError)
+	if !ok {
+		return nil, false
+	}
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+	data, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Find out why the transaction failed by replaying it with eth_call on top of the
+// block before the receipt block.
+// The replay doesn't include the transactions that came before it in the same block,
+// so it may not reproduce the revert; in this case, return an error saying so.
+func _replayTransaction(
+	ctx context.Context,
+	client *ethclient.Client,
+	tx *types.Transaction,
+	receipt *types.Receipt,
+	trace bool,
+) error {
+
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return fmt.Errorf("transaction failed; failed to recover sender: %v", err)
+	}
+	msg := ethereum.CallMsg{
+		From:  sender,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	parent := new(big.Int).Sub(receipt.BlockNumber, common.Big1)
+	_, err = client.CallContract(ctx, msg, parent)
+	if err == nil {
+		return fmt.Errorf("transaction %v failed; could not reproduce revert", tx.Hash())
+	}
+	data, ok := revertData(err)
+	if !ok && !strings.Contains(err.Error(), "execution reverted") {
+		return fmt.Errorf("transaction failed; failed to replay: %v", err)
+	}
+	revertErr := decodeRevert(tx.Hash(), data)
+	if trace {
+		revertErr.Trace, revertErr.TraceErr = _traceTransaction(ctx, client, tx.Hash())
+	}
+	return revertErr
+}
+
+func _traceTransaction(
+	ctx context.Context,
+	client *ethclient.Client,
+	hash common.Hash,
+) (string, error) {
+	// We make a call using the rpc client directly because this function
+	// is not present in the ethclient struct. More details in:
+	// https://github.com/ethereum/go-ethereum/issues/17341
+	var result json.RawMessage
+	err := client.Client().CallContext(ctx, &result, "debug_traceTransaction", hash)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
+)
+
+// Error with revert data returned by the stub node.
+type stubRevert struct {
+	data []byte
+}
+
+func (e stubRevert) Error() string {
+	return "execution reverted"
+}
+
+func (e stubRevert) ErrorData() any {
+	return hexutil.Encode(e.data)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	hash := common.HexToHash("0x01")
+
+	// Error(string)
+	data := common.Hex2Bytes("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"6f6f707300000000000000000000000000000000000000000000000000000000")
+	revertErr := decodeRevert(hash, data)
+	if revertErr.Name != "Error" || !reflect.DeepEqual(revertErr.Args, []any{"oops"}) {
+		t.Fatalf("wrong revert error: %v %v", revertErr.Name, revertErr.Args)
+	}
+	expectedMsg := "transaction " + hash.String() + " reverted: oops"
+	if revertErr.Error() != expectedMsg {
+		t.Fatalf("wrong message: %v", revertErr.Error())
+	}
+
+	// Panic(uint256)
+	data = common.Hex2Bytes("4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011")
+	revertErr = decodeRevert(common.Hash{}, data)
+	if revertErr.Name != "Panic" {
+		t.Fatalf("wrong name: %v", revertErr.Name)
+	}
+	if revertErr.Error() != "execution panicked: arithmetic underflow or overflow" {
+		t.Fatalf("wrong message: %v", revertErr.Error())
+	}
+
+	// Custom error from the TestERC20 contract
+	parsed, _ := bindings.TestERC20MetaData.GetAbi()
+	abiErr := parsed.Errors["ERC20InsufficientBalance"]
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	args, _ := abiErr.Inputs.Pack(sender, big.NewInt(1), big.NewInt(2))
+	data = append(common.CopyBytes(abiErr.ID[:4]), args...)
+	revertErr = decodeRevert(common.Hash{}, data)
+	if revertErr.Name != "ERC20InsufficientBalance" || len(revertErr.Args) != 3 {
+		t.Fatalf("wrong revert error: %v %v", revertErr.Name, revertErr.Args)
+	}
+	if revertErr.Selector != [4]byte(abiErr.ID[:4]) {
+		t.Fatalf("wrong selector: %x", revertErr.Selector)
+	}
+	if revertErr.Args[0] != sender {
+		t.Fatalf("wrong arg: %v", revertErr.Args[0])
+	}
+
+	// Unknown error
+	revertErr = decodeRevert(common.Hash{}, common.Hex2Bytes("deadbeef"))
+	if revertErr.Name != "" || revertErr.Error() != "execution reverted with unknown error: 0xdeadbeef" {
+		t.Fatalf("wrong revert error: %v", revertErr)
+	}
+
+	// No data
+	revertErr = decodeRevert(common.Hash{}, nil)
+	if revertErr.Error() != "execution reverted without reason" {
+		t.Fatalf("wrong message: %v", revertErr.Error())
+	}
+}
+
+func TestReplayTransaction(t *testing.T) {
+	data := common.Hex2Bytes("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"6f6f707300000000000000000000000000000000000000000000000000000000")
+	var callResult any = stubRevert{data}
+	var traceResult any = map[string]any{"failed": true}
+	client := setupStubNode(t, map[string]any{
+		"eth_call": func(params []json.RawMessage) any {
+			if string(params[1]) != `"0x9"` {
+				t.Errorf("wrong replay block: %s", params[1])
+			}
+			return callResult
+		},
+		"debug_traceTransaction": func() any { return traceResult },
+	})
+	chainId := big.NewInt(31337)
+	privateKey, _ := crypto.HexToECDSA(testPrivateKey)
+	to := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	tx, _ := types.SignNewTx(privateKey, types.LatestSignerForChainID(chainId), &types.DynamicFeeTx{
+		ChainID:   chainId,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(0),
+	})
+	receipt := &types.Receipt{BlockNumber: big.NewInt(10)}
+
+	err := _replayTransaction(context.Background(), client, tx, receipt, false)
+	revertErr, ok := err.(*RevertError)
+	if !ok {
+		t.Fatalf("expected revert error; got %v", err)
+	}
+	if revertErr.TxHash != tx.Hash() || revertErr.Args[0] != "oops" || revertErr.Trace != "" {
+		t.Fatalf("wrong revert error: %v", revertErr)
+	}
+
+	err = _replayTransaction(context.Background(), client, tx, receipt, true)
+	revertErr = err.(*RevertError)
+	if revertErr.Trace != `{"failed":true}` {
+		t.Fatalf("wrong trace: %v", revertErr.Trace)
+	}
+
+	// the trace fails but the revert reason is kept
+	traceResult = errors.New("method not available")
+	err = _replayTransaction(context.Background(), client, tx, receipt, true)
+	revertErr = err.(*RevertError)
+	if revertErr.Args[0] != "oops" || revertErr.TraceErr == nil {
+		t.Fatalf("wrong revert error: %v", revertErr)
+	}
+	if !strings.Contains(revertErr.Error(), "failed to trace: method not available") {
+		t.Fatalf("wrong message: %v", revertErr.Error())
+	}
+
+	// the replay succeeds
+	callResult = "0x"
+	err = _replayTransaction(context.Background(), client, tx, receipt, false)
+	if _, ok := err.(*RevertError); ok || !strings.Contains(err.Error(), "could not reproduce revert") {
+		t.Fatalf("expected could not reproduce error; got %v", err)
+	}
+}
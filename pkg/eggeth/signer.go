@@ -0,0 +1,87 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Configuration to create a signer.
+// Only one of the private key, the keystore, or the remote signer may be set.
+// If none is set, use the mnemonic.
+type SignerConfig struct {
+
+	// Mnemonic for the mnemonic signer; if empty, use the Foundry's test mnemonic.
+	Mnemonic string
+
+	// Account index for the mnemonic signer.
+	MnemonicAccountIndex uint32
+
+	// Options for the mnemonic signer.
+	MnemonicOpts MnemonicOpts
+
+	// Hex-encoded private key for the private key signer.
+	PrivateKey string
+
+	// Path to the JSON keystore file for the keystore signer.
+	KeystorePath string
+
+	// Passphrase of the keystore file.
+	KeystorePassphrase string
+
+	// JSON-RPC endpoint of the remote signer.
+	RemoteEndpoint string
+
+	// Account of the remote signer.
+	RemoteAccount common.Address
+}
+
+// Create the default signer config, which uses the Foundry's test mnemonic.
+func MakeSignerConfig() SignerConfig {
+	return SignerConfig{
+		Mnemonic:     FoundryMnemonic,
+		MnemonicOpts: MakeMnemonicOpts(),
+	}
+}
+
+// Create the signer described by the config.
+func NewSigner(config SignerConfig, chainId *big.Int) (Signer, error) {
+	numSources := 0
+	for _, source := range []string{
+		config.PrivateKey, config.KeystorePath, config.RemoteEndpoint,
+	} {
+		if source != "" {
+			numSources++
+		}
+	}
+	if numSources > 1 {
+		return nil, fmt.Errorf("more than one signer configured")
+	}
+
+	switch {
+	case config.PrivateKey != "":
+		return NewPrivateKeySignerFromHex(config.PrivateKey, chainId)
+	case config.KeystorePath != "":
+		return NewKeystoreSigner(config.KeystorePath, config.KeystorePassphrase, chainId)
+	case config.RemoteEndpoint != "":
+		if config.RemoteAccount == (common.Address{}) {
+			return nil, fmt.Errorf("missing remote signer account")
+		}
+		return NewRemoteSigner(config.RemoteEndpoint, config.RemoteAccount, chainId)
+	default:
+		mnemonic := config.Mnemonic
+		if mnemonic == "" {
+			mnemonic = FoundryMnemonic
+		}
+		opts := config.MnemonicOpts
+		if opts.DerivationPath == "" {
+			opts.DerivationPath = DefaultDerivationPath
+		}
+		return NewMnemonicSignerWithOpts(
+			mnemonic, config.MnemonicAccountIndex, chainId, opts)
+	}
+}
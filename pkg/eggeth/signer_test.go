@@ -0,0 +1,205 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Private key of the account 0 of the Foundry's test mnemonic.
+const testPrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+var testAccount = common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+// Sign a dummy transaction with the signer and check the sender.
+func testSigner(t *testing.T, signer Signer, chainId *big.Int) {
+	if signer.Account() != testAccount {
+		t.Fatalf("wrong account: %v", signer.Account())
+	}
+	txOpts, err := signer.MakeTransactor()
+	if err != nil {
+		t.Fatalf("failed to create transactor: %v", err)
+	}
+	to := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(1000),
+		Gas:      21000,
+		To:       &to,
+		Value:    big.NewInt(1),
+	})
+	signedTx, err := txOpts.Signer(txOpts.From, tx)
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signedTx)
+	if err != nil {
+		t.Fatalf("failed to recover sender: %v", err)
+	}
+	if sender != testAccount {
+		t.Fatalf("wrong sender: %v", sender)
+	}
+	if signedTx.Nonce() != 1 || *signedTx.To() != to {
+		t.Fatalf("wrong signed transaction")
+	}
+}
+
+func TestPrivateKeySigner(t *testing.T) {
+	chainId := big.NewInt(31337)
+	signer, err := NewPrivateKeySignerFromHex("0x"+testPrivateKey, chainId)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	testSigner(t, signer, chainId)
+
+	t.Setenv("EGGROLL_TEST_PRIVATE_KEY", testPrivateKey)
+	signer, err = NewPrivateKeySignerFromEnv("EGGROLL_TEST_PRIVATE_KEY", chainId)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	testSigner(t, signer, chainId)
+
+	_, err = NewPrivateKeySignerFromEnv("EGGROLL_TEST_MISSING_KEY", chainId)
+	if err == nil {
+		t.Fatalf("expected error; got nil")
+	}
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	chainId := big.NewInt(31337)
+	privateKey, _ := crypto.HexToECDSA(testPrivateKey)
+	key := &keystore.Key{
+		Address:    testAccount,
+		PrivateKey: privateKey,
+	}
+	keyJson, err := keystore.EncryptKey(
+		key, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatalf("failed to encrypt key: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "key.json")
+	if err := os.WriteFile(path, keyJson, 0600); err != nil {
+		t.Fatalf("failed to write keystore: %v", err)
+	}
+
+	signer, err := NewKeystoreSigner(path, "secret", chainId)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	testSigner(t, signer, chainId)
+
+	_, err = NewKeystoreSigner(path, "wrong", chainId)
+	if err == nil {
+		t.Fatalf("expected error; got nil")
+	}
+}
+
+// Start a stub JSON-RPC server that signs the transactions with the test key.
+func setupRemoteSigner(t *testing.T, chainId *big.Int) string {
+	privateKey, _ := crypto.HexToECDSA(testPrivateKey)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var request struct {
+				ID     json.RawMessage         `json:"id"`
+				Method string                  `json:"method"`
+				Params []remoteTransactionArgs `json:"params"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			if request.Method != "eth_signTransaction" || len(request.Params) != 1 {
+				t.Errorf("unexpected request: %v", request.Method)
+			}
+			args := request.Params[0]
+			if args.ChainID.ToInt().Cmp(chainId) != 0 {
+				t.Errorf("wrong chain id: %v", args.ChainID)
+			}
+			tx := types.NewTx(&types.LegacyTx{
+				Nonce:    uint64(args.Nonce),
+				GasPrice: args.GasPrice.ToInt(),
+				Gas:      uint64(args.Gas),
+				To:       args.To,
+				Value:    args.Value.ToInt(),
+				Data:     args.Data,
+			})
+			signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(chainId), privateKey)
+			if err != nil {
+				t.Errorf("failed to sign: %v", err)
+			}
+			raw, _ := signedTx.MarshalBinary()
+			result, _ := json.Marshal(map[string]any{
+				"raw": hexutil.Bytes(raw),
+				"tx":  signedTx,
+			})
+			response, _ := json.Marshal(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      request.ID,
+				"result":  json.RawMessage(result),
+			})
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(response)
+		},
+	))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestRemoteSigner(t *testing.T) {
+	chainId := big.NewInt(31337)
+	endpoint := setupRemoteSigner(t, chainId)
+	signer, err := NewRemoteSigner(endpoint, testAccount, chainId)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	testSigner(t, signer, chainId)
+
+	// the remote signer signs with the test key instead of the expected account
+	other := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	signer, err = NewRemoteSigner(endpoint, other, chainId)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	txOpts, _ := signer.MakeTransactor()
+	tx := types.NewTx(&types.LegacyTx{GasPrice: big.NewInt(1), Value: big.NewInt(0)})
+	if _, err := txOpts.Signer(other, tx); err == nil {
+		t.Fatalf("expected error; got nil")
+	}
+}
+
+func TestNewSigner(t *testing.T) {
+	chainId := big.NewInt(31337)
+	signer, err := NewSigner(MakeSignerConfig(), chainId)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	testSigner(t, signer, chainId)
+
+	config := MakeSignerConfig()
+	config.PrivateKey = testPrivateKey
+	signer, err = NewSigner(config, chainId)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	if _, ok := signer.(*PrivateKeySigner); !ok {
+		t.Fatalf("expected private key signer; got %T", signer)
+	}
+
+	config.KeystorePath = "key.json"
+	_, err = NewSigner(config, chainId)
+	if err == nil || err.Error() != "more than one signer configured" {
+		t.Fatalf("wrong error: %v", err)
+	}
+}
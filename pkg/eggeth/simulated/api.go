@@ -0,0 +1,285 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package simulated
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Implements the subset of the eth namespace of the JSON-RPC API used by the ETHClient.
+// The go-ethereum simulated backend doesn't serve JSON-RPC and the ETHClient talks to
+// the node through an RPC client, so the backend is exposed through this in-process API.
+// Each transaction is mined in a new block as soon as it is received, like anvil does.
+type ethAPI struct {
+	backend *backends.SimulatedBackend
+	mutex   sync.Mutex
+}
+
+// Arguments of eth_call and eth_estimateGas.
+type callArgs struct {
+	From                 *common.Address `json:"from"`
+	To                   *common.Address `json:"to"`
+	Gas                  *hexutil.Uint64 `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big    `json:"value"`
+	Data                 *hexutil.Bytes  `json:"data"`
+	Input                *hexutil.Bytes  `json:"input"`
+}
+
+func (args callArgs) toCallMsg() ethereum.CallMsg {
+	var msg ethereum.CallMsg
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	msg.To = args.To
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	msg.GasPrice = (*big.Int)(args.GasPrice)
+	msg.GasFeeCap = (*big.Int)(args.MaxFeePerGas)
+	msg.GasTipCap = (*big.Int)(args.MaxPriorityFeePerGas)
+	msg.Value = (*big.Int)(args.Value)
+	if args.Input != nil {
+		msg.Data = *args.Input
+	} else if args.Data != nil {
+		msg.Data = *args.Data
+	}
+	return msg
+}
+
+// Convert the block number to the argument of the simulated backend.
+// The backend uses nil for the latest block.
+func (api *ethAPI) blockNumber(number *rpc.BlockNumber) *big.Int {
+	if number == nil || *number < 0 {
+		return nil
+	}
+	return big.NewInt(number.Int64())
+}
+
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.backend.Blockchain().Config().ChainID)
+}
+
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.backend.Blockchain().CurrentBlock().Number.Uint64())
+}
+
+func (api *ethAPI) GetBlockByNumber(
+	ctx context.Context, number rpc.BlockNumber, fullTx bool) (map[string]any, error) {
+
+	block, err := api.backend.BlockByNumber(ctx, api.blockNumber(&number))
+	if err != nil {
+		return nil, nil
+	}
+	return api.marshalBlock(block, fullTx)
+}
+
+func (api *ethAPI) GetBlockByHash(
+	ctx context.Context, hash common.Hash, fullTx bool) (map[string]any, error) {
+
+	block, err := api.backend.BlockByHash(ctx, hash)
+	if err != nil {
+		return nil, nil
+	}
+	return api.marshalBlock(block, fullTx)
+}
+
+func (api *ethAPI) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tip, err := api.backend.SuggestGasTipCap(ctx)
+	return (*hexutil.Big)(tip), err
+}
+
+func (api *ethAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := api.backend.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+func (api *ethAPI) GetBalance(
+	ctx context.Context, account common.Address, number *rpc.BlockNumber) (*hexutil.Big, error) {
+
+	balance, err := api.backend.BalanceAt(ctx, account, api.blockNumber(number))
+	return (*hexutil.Big)(balance), err
+}
+
+func (api *ethAPI) GetCode(
+	ctx context.Context, account common.Address, number *rpc.BlockNumber) (hexutil.Bytes, error) {
+
+	if number != nil && *number == rpc.PendingBlockNumber {
+		return api.backend.PendingCodeAt(ctx, account)
+	}
+	return api.backend.CodeAt(ctx, account, api.blockNumber(number))
+}
+
+func (api *ethAPI) GetTransactionCount(
+	ctx context.Context, account common.Address, number *rpc.BlockNumber) (hexutil.Uint64, error) {
+
+	var nonce uint64
+	var err error
+	if number != nil && *number == rpc.PendingBlockNumber {
+		nonce, err = api.backend.PendingNonceAt(ctx, account)
+	} else {
+		nonce, err = api.backend.NonceAt(ctx, account, api.blockNumber(number))
+	}
+	return hexutil.Uint64(nonce), err
+}
+
+func (api *ethAPI) Call(
+	ctx context.Context, args callArgs, number *rpc.BlockNumber) (hexutil.Bytes, error) {
+
+	return api.backend.CallContract(ctx, args.toCallMsg(), api.blockNumber(number))
+}
+
+func (api *ethAPI) EstimateGas(
+	ctx context.Context, args callArgs, number *rpc.BlockNumber) (hexutil.Uint64, error) {
+
+	gas, err := api.backend.EstimateGas(ctx, args.toCallMsg())
+	return hexutil.Uint64(gas), err
+}
+
+func (api *ethAPI) SendRawTransaction(
+	ctx context.Context, input hexutil.Bytes) (hash common.Hash, err error) {
+
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	// The simulated backend panics when the transaction can't be included in a block.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid transaction: %v", r)
+		}
+	}()
+
+	nonce, err := api.backend.PendingNonceAt(ctx, senderOf(&tx))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if tx.Nonce() < nonce {
+		return common.Hash{}, errors.New("nonce too low")
+	}
+	if tx.Nonce() > nonce {
+		return common.Hash{}, errors.New("nonce too high")
+	}
+	if err := api.backend.SendTransaction(ctx, &tx); err != nil {
+		return common.Hash{}, err
+	}
+	api.backend.Commit()
+	return tx.Hash(), nil
+}
+
+func (api *ethAPI) GetTransactionByHash(
+	ctx context.Context, hash common.Hash) (map[string]any, error) {
+
+	tx, _, err := api.backend.TransactionByHash(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	receipt, err := api.backend.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return marshalTransaction(tx, nil)
+	}
+	return marshalTransaction(tx, receipt)
+}
+
+func (api *ethAPI) GetTransactionReceipt(
+	ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+
+	receipt, err := api.backend.TransactionReceipt(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	}
+	return receipt, err
+}
+
+func (api *ethAPI) GetLogs(
+	ctx context.Context, criteria filters.FilterCriteria) ([]types.Log, error) {
+
+	logs, err := api.backend.FilterLogs(ctx, ethereum.FilterQuery(criteria))
+	if logs == nil {
+		logs = []types.Log{}
+	}
+	return logs, err
+}
+
+// Marshal the block with the transaction hashes or the full transactions.
+func (api *ethAPI) marshalBlock(block *types.Block, fullTx bool) (map[string]any, error) {
+	fields, err := marshalToMap(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	txs := []any{}
+	for _, tx := range block.Transactions() {
+		if fullTx {
+			receipt, err := api.backend.TransactionReceipt(context.Background(), tx.Hash())
+			if err != nil {
+				return nil, err
+			}
+			txFields, err := marshalTransaction(tx, receipt)
+			if err != nil {
+				return nil, err
+			}
+			txs = append(txs, txFields)
+		} else {
+			txs = append(txs, tx.Hash())
+		}
+	}
+	fields["transactions"] = txs
+	fields["uncles"] = []common.Hash{}
+	return fields, nil
+}
+
+// Marshal the transaction with the sender and the block information from the receipt.
+func marshalTransaction(tx *types.Transaction, receipt *types.Receipt) (map[string]any, error) {
+	fields, err := marshalToMap(tx)
+	if err != nil {
+		return nil, err
+	}
+	fields["from"] = senderOf(tx)
+	if receipt != nil {
+		fields["blockHash"] = receipt.BlockHash
+		fields["blockNumber"] = (*hexutil.Big)(receipt.BlockNumber)
+		fields["transactionIndex"] = hexutil.Uint64(receipt.TransactionIndex)
+	}
+	return fields, nil
+}
+
+func marshalToMap(value any) (map[string]any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// Recover the sender of a signed transaction.
+// It returns the zero address if the signature is invalid.
+func senderOf(tx *types.Transaction) common.Address {
+	sender, _ := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	return sender
+}
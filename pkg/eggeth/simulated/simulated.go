@@ -0,0 +1,332 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+// Provide an in-memory Ethereum backend for tests.
+// It is not part of eggeth because the go-ethereum simulated backend pulls the
+// go-ethereum core and its database, which shouldn't be linked into the DApps.
+package simulated
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
+)
+
+// Options to create the backend.
+type BackendOpts struct {
+
+	// Number of Foundry mnemonic accounts funded at genesis.
+	FundedAccounts uint32
+
+	// Balance of each funded account.
+	Balance *big.Int
+
+	// Gas limit of each block.
+	GasLimit uint64
+
+	// Template hash of the DApp.
+	TemplateHash common.Hash
+}
+
+// Create the options with default values.
+func MakeBackendOpts() BackendOpts {
+	return BackendOpts{
+		FundedAccounts: 10,
+		Balance:        new(big.Int).Mul(big.NewInt(10_000), big.NewInt(params.Ether)),
+		GasLimit:       30_000_000,
+		TemplateHash:   common.HexToHash("0x1"),
+	}
+}
+
+// In-memory Ethereum backend based on the go-ethereum simulated backend.
+// It provides an ETHClient wired to the backend, so tests don't need a node.
+// At startup, the account 0 of the Foundry mnemonic deploys the test ERC20, the Cartesi
+// Rollups contracts, and a DApp contract whose consensus is an Authority.
+type Backend struct {
+
+	// Address of the test ERC20 contract; the account 0 of the Foundry mnemonic has the supply.
+	ERC20Token common.Address
+
+	// Address of the DApp contract, created by the CartesiDAppFactory.
+	DAppAddress common.Address
+
+	// Address of the Authority consensus of the DApp; the account 0 submits the claims.
+	Authority common.Address
+
+	backend    *backends.SimulatedBackend
+	rpcClient  *rpc.Client
+	client     *ethclient.Client
+	deployment eggeth.Deployment
+}
+
+// Create the backend with the default options.
+func NewBackend(ctx context.Context) (*Backend, error) {
+	return NewBackendWithOpts(ctx, MakeBackendOpts())
+}
+
+// Create the backend.
+func NewBackendWithOpts(ctx context.Context, opts BackendOpts) (*Backend, error) {
+	alloc := make(core.GenesisAlloc)
+	chainId := params.AllDevChainProtocolChanges.ChainID
+	for i := uint32(0); i < opts.FundedAccounts; i++ {
+		signer, err := eggeth.NewMnemonicSigner(eggeth.FoundryMnemonic, i, chainId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create signer: %v", err)
+		}
+		alloc[signer.Account()] = core.GenesisAccount{Balance: opts.Balance}
+	}
+
+	backend := backends.NewSimulatedBackend(alloc, opts.GasLimit)
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &ethAPI{backend: backend}); err != nil {
+		backend.Close()
+		return nil, fmt.Errorf("failed to register rpc api: %v", err)
+	}
+	rpcClient := rpc.DialInProc(server)
+	b := &Backend{
+		backend:   backend,
+		rpcClient: rpcClient,
+		client:    ethclient.NewClient(rpcClient),
+		deployment: eggeth.Deployment{
+			Network: "simulated",
+			ChainID: chainId.Uint64(),
+		},
+	}
+
+	if err := b.setup(ctx, opts); err != nil {
+		b.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+// Deploy the test contracts.
+func (b *Backend) setup(ctx context.Context, opts BackendOpts) error {
+	signer, err := b.Signer(0)
+	if err != nil {
+		return err
+	}
+	txOpts, err := signer.MakeTransactor()
+	if err != nil {
+		return fmt.Errorf("failed to create transactor: %v", err)
+	}
+	txOpts.Context = ctx
+
+	_, tx, _, err := bindings.DeployTestERC20(txOpts, b.client, signer.Account())
+	receipt, err := b.waitDeployed(ctx, "TestERC20", tx, err)
+	if err != nil {
+		return err
+	}
+	b.ERC20Token = receipt.ContractAddress
+
+	d := &b.deployment
+	_, tx, _, err = bindings.DeployInputBox(txOpts, b.client)
+	receipt, err = b.waitDeployed(ctx, "InputBox", tx, err)
+	if err != nil {
+		return err
+	}
+	d.InputBox = receipt.ContractAddress
+	d.InputBoxBlock = receipt.BlockNumber.Uint64()
+
+	for _, contract := range []struct {
+		name    string
+		address *common.Address
+		deploy  func() (*types.Transaction, error)
+	}{
+		{"EtherPortal", &d.EtherPortal, func() (tx *types.Transaction, err error) {
+			_, tx, _, err = bindings.DeployEtherPortal(txOpts, b.client, d.InputBox)
+			return tx, err
+		}},
+		{"ERC20Portal", &d.ERC20Portal, func() (tx *types.Transaction, err error) {
+			_, tx, _, err = bindings.DeployERC20Portal(txOpts, b.client, d.InputBox)
+			return tx, err
+		}},
+		{"ERC721Portal", &d.ERC721Portal, func() (tx *types.Transaction, err error) {
+			_, tx, _, err = bindings.DeployERC721Portal(txOpts, b.client, d.InputBox)
+			return tx, err
+		}},
+		{"ERC1155SinglePortal", &d.ERC1155SinglePortal, func() (tx *types.Transaction, err error) {
+			_, tx, _, err = bindings.DeployERC1155SinglePortal(txOpts, b.client, d.InputBox)
+			return tx, err
+		}},
+		{"ERC1155BatchPortal", &d.ERC1155BatchPortal, func() (tx *types.Transaction, err error) {
+			_, tx, _, err = bindings.DeployERC1155BatchPortal(txOpts, b.client, d.InputBox)
+			return tx, err
+		}},
+		{"DAppAddressRelay", &d.DAppAddressRelay, func() (tx *types.Transaction, err error) {
+			_, tx, _, err = bindings.DeployDAppAddressRelay(txOpts, b.client, d.InputBox)
+			return tx, err
+		}},
+		{"CartesiDAppFactory", &d.CartesiDAppFactory, func() (tx *types.Transaction, err error) {
+			_, tx, _, err = bindings.DeployCartesiDAppFactory(txOpts, b.client)
+			return tx, err
+		}},
+		{"Authority", &b.Authority, func() (tx *types.Transaction, err error) {
+			_, tx, _, err = bindings.DeployAuthority(txOpts, b.client, signer.Account())
+			return tx, err
+		}},
+	} {
+		tx, err := contract.deploy()
+		receipt, err := b.waitDeployed(ctx, contract.name, tx, err)
+		if err != nil {
+			return err
+		}
+		*contract.address = receipt.ContractAddress
+	}
+
+	// The authority owns the history, so only it can submit claims.
+	_, tx, _, err = bindings.DeployHistory(txOpts, b.client, b.Authority)
+	receipt, err = b.waitDeployed(ctx, "History", tx, err)
+	if err != nil {
+		return err
+	}
+	authority, err := bindings.NewAuthority(b.Authority, b.client)
+	if err != nil {
+		return fmt.Errorf("failed to bind authority: %v", err)
+	}
+	tx, err = authority.SetHistory(txOpts, receipt.ContractAddress)
+	if _, err := b.waitMined(ctx, tx, err); err != nil {
+		return fmt.Errorf("failed to set history: %v", err)
+	}
+
+	client, err := b.ETHClient()
+	if err != nil {
+		return err
+	}
+	b.DAppAddress, err = client.DeployDApp(
+		ctx, signer, b.Authority, common.Address{}, opts.TemplateHash, common.Hash{}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to deploy dapp: %v", err)
+	}
+	return nil
+}
+
+// Wait for the deployment transaction and return its receipt.
+func (b *Backend) waitDeployed(ctx context.Context, name string, tx *types.Transaction, err error) (
+	*types.Receipt, error) {
+
+	receipt, err := b.waitMined(ctx, tx, err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy %v: %v", name, err)
+	}
+	return receipt, nil
+}
+
+// Wait for the transaction and check whether it succeeded.
+func (b *Backend) waitMined(ctx context.Context, tx *types.Transaction, err error) (
+	*types.Receipt, error) {
+
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := bind.WaitMined(ctx, b.client, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transaction %v reverted", tx.Hash())
+	}
+	return receipt, nil
+}
+
+// Submit the claim of an epoch with the inputs from firstIndex to lastIndex to the Authority.
+// The epoch hash is the hash of the vouchers and notices roots and the machine state.
+// The claims must cover the inputs in order; the proof context of the outputs of the epoch
+// is the index of the claim encoded as a 32-byte integer.
+func (b *Backend) SubmitClaim(
+	ctx context.Context, epochHash common.Hash, firstIndex uint64, lastIndex uint64) error {
+
+	claimData, err := claimArguments.Pack(b.DAppAddress, claim{
+		EpochHash:  epochHash,
+		FirstIndex: new(big.Int).SetUint64(firstIndex),
+		LastIndex:  new(big.Int).SetUint64(lastIndex),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode claim: %v", err)
+	}
+	signer, err := b.Signer(0)
+	if err != nil {
+		return err
+	}
+	txOpts, err := signer.MakeTransactor()
+	if err != nil {
+		return fmt.Errorf("failed to create transactor: %v", err)
+	}
+	txOpts.Context = ctx
+	authority, err := bindings.NewAuthority(b.Authority, b.client)
+	if err != nil {
+		return fmt.Errorf("failed to bind authority: %v", err)
+	}
+	tx, err := authority.SubmitClaim(txOpts, claimData)
+	if _, err := b.waitMined(ctx, tx, err); err != nil {
+		return fmt.Errorf("failed to submit claim: %v", err)
+	}
+	return nil
+}
+
+// Claim of the History contract.
+type claim struct {
+	EpochHash  [32]byte
+	FirstIndex *big.Int
+	LastIndex  *big.Int
+}
+
+// Encoding of the claim data of the History contract.
+var claimArguments = func() abi.Arguments {
+	claimType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "epochHash", Type: "bytes32"},
+		{Name: "firstIndex", Type: "uint128"},
+		{Name: "lastIndex", Type: "uint128"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	addressType, err := abi.NewType("address", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Type: addressType}, {Type: claimType}}
+}()
+
+// Create an ETH client for the DApp contract of the backend.
+func (b *Backend) ETHClient() (*eggeth.ETHClient, error) {
+	return eggeth.NewETHClientWithRPC(b.rpcClient, b.DAppAddress, b.deployment)
+}
+
+// Get the go-ethereum client connected to the backend.
+func (b *Backend) Client() *ethclient.Client {
+	return b.client
+}
+
+// Get the deployment of the Cartesi Rollups contracts in the backend.
+func (b *Backend) Deployment() eggeth.Deployment {
+	return b.deployment
+}
+
+// Create a signer for the given account of the Foundry mnemonic.
+func (b *Backend) Signer(accountIndex uint32) (eggeth.Signer, error) {
+	signer, err := eggeth.NewMnemonicSigner(
+		eggeth.FoundryMnemonic, accountIndex, params.AllDevChainProtocolChanges.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signer: %v", err)
+	}
+	return signer, nil
+}
+
+// Close the backend.
+func (b *Backend) Close() {
+	b.client.Close()
+	b.backend.Close()
+}
@@ -0,0 +1,268 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package simulated
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
+	"github.com/gligneul/eggroll/pkg/eggwallets"
+)
+
+func TestBackend(t *testing.T) {
+	ctx := context.Background()
+	backend, err := NewBackend(ctx)
+	if err != nil {
+		t.Fatalf("failed to create backend: %v", err)
+	}
+	defer backend.Close()
+
+	client, err := backend.ETHClient()
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		t.Fatalf("failed to get chain id: %v", err)
+	}
+	if chainID.Uint64() != backend.Deployment().ChainID {
+		t.Fatalf("wrong chain id: %v", chainID)
+	}
+
+	token, err := bindings.NewTestERC20(backend.ERC20Token, backend.Client())
+	if err != nil {
+		t.Fatalf("failed to bind erc20: %v", err)
+	}
+	owner, _ := backend.Signer(0)
+	other, _ := backend.Signer(1)
+	supply, err := token.BalanceOf(nil, owner.Account())
+	if err != nil {
+		t.Fatalf("failed to get balance: %v", err)
+	}
+	if supply.Sign() <= 0 {
+		t.Fatalf("expected supply in account 0")
+	}
+
+	// transfer from the owner
+	txOpts, _ := owner.MakeTransactor()
+	tx, err := token.Transfer(txOpts, other.Account(), big.NewInt(100))
+	if err != nil {
+		t.Fatalf("failed to transfer: %v", err)
+	}
+	if _, err := bind.WaitMined(ctx, backend.Client(), tx); err != nil {
+		t.Fatalf("failed to wait for transfer: %v", err)
+	}
+	balance, err := token.BalanceOf(nil, other.Account())
+	if err != nil {
+		t.Fatalf("failed to get balance: %v", err)
+	}
+	if balance.Int64() != 100 {
+		t.Fatalf("wrong balance: %v", balance)
+	}
+	it, err := token.FilterTransfer(nil, []common.Address{owner.Account()}, []common.Address{other.Account()})
+	if err != nil {
+		t.Fatalf("failed to filter transfers: %v", err)
+	}
+	if !it.Next() || it.Event.Value.Int64() != 100 {
+		t.Fatalf("transfer event not found")
+	}
+	it.Close()
+
+	// transfer more than the balance
+	txOpts, _ = other.MakeTransactor()
+	_, err = token.Transfer(txOpts, owner.Account(), big.NewInt(101))
+	if err == nil || !strings.Contains(err.Error(), "execution reverted") {
+		t.Fatalf("expected revert; got %v", err)
+	}
+}
+
+func TestBackendRollups(t *testing.T) {
+	ctx := context.Background()
+	backend, err := NewBackend(ctx)
+	if err != nil {
+		t.Fatalf("failed to create backend: %v", err)
+	}
+	defer backend.Close()
+
+	client, err := backend.ETHClient()
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	deployment := client.Deployment()
+	if err := deployment.Validate(); err != nil {
+		t.Fatalf("invalid deployment: %v", err)
+	}
+	consensus, err := client.Consensus(ctx)
+	if err != nil || consensus != backend.Authority {
+		t.Fatalf("wrong consensus: %v %v", consensus, err)
+	}
+	signer, _ := backend.Signer(0)
+	recipient, _ := backend.Signer(1)
+
+	inputIndex, err := client.SendInput(ctx, signer, common.Hex2Bytes("deadbeef"), nil)
+	if err != nil {
+		t.Fatalf("failed to send input: %v", err)
+	}
+	input, err := client.GetInput(ctx, inputIndex)
+	if err != nil {
+		t.Fatalf("failed to get input: %v", err)
+	}
+	if inputIndex != 0 || input.Sender != signer.Account() ||
+		!bytes.Equal(input.Payload, common.Hex2Bytes("deadbeef")) {
+		t.Fatalf("wrong input: %v %v %x", inputIndex, input.Sender, input.Payload)
+	}
+
+	inputIndex, err = client.SendEther(ctx, signer, big.NewInt(65535), nil, nil)
+	if err != nil {
+		t.Fatalf("failed to send ether: %v", err)
+	}
+	input, err = client.GetInput(ctx, inputIndex)
+	if err != nil {
+		t.Fatalf("failed to get input: %v", err)
+	}
+	etherDeposit, ok := input.Deposit.(*eggwallets.EtherDeposit)
+	if inputIndex != 1 || input.Sender != backend.Deployment().EtherPortal || !ok ||
+		etherDeposit.Sender != signer.Account() || etherDeposit.Value.Int64() != 65535 {
+		t.Fatalf("wrong ether deposit: %v %v %+v", inputIndex, input.Sender, input.Deposit)
+	}
+	balance, err := backend.Client().BalanceAt(ctx, backend.DAppAddress, nil)
+	if err != nil || balance.Int64() != 65535 {
+		t.Fatalf("wrong dapp balance: %v %v", balance, err)
+	}
+
+	inputIndex, err = client.SendERC20Tokens(
+		ctx, signer, backend.ERC20Token, big.NewInt(65535), nil, nil)
+	if err != nil {
+		t.Fatalf("failed to send tokens: %v", err)
+	}
+	input, err = client.GetInput(ctx, inputIndex)
+	if err != nil {
+		t.Fatalf("failed to get input: %v", err)
+	}
+	erc20Deposit, ok := input.Deposit.(*eggwallets.ERC20Deposit)
+	if inputIndex != 2 || input.Sender != backend.Deployment().ERC20Portal || !ok ||
+		erc20Deposit.Token != backend.ERC20Token || erc20Deposit.Amount.Int64() != 65535 {
+		t.Fatalf("wrong erc20 deposit: %v %v %+v", inputIndex, input.Sender, input.Deposit)
+	}
+
+	// Voucher of the input 2 that transfers part of the deposit to the recipient
+	tokenABI, err := bindings.TestERC20MetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("failed to get abi: %v", err)
+	}
+	payload, err := tokenABI.Pack("transfer", recipient.Account(), big.NewInt(100))
+	if err != nil {
+		t.Fatalf("failed to encode transfer: %v", err)
+	}
+	proof, epochHash := makeVoucherProof(backend.ERC20Token, payload, 2, 0)
+
+	// The voucher can't be executed before the claim
+	err = client.ExecuteVoucher(ctx, signer, backend.ERC20Token, payload, proof, nil)
+	if err == nil {
+		t.Fatalf("executed voucher without claim")
+	}
+	closed, err := client.EpochClosed(ctx, 2)
+	if err != nil || closed {
+		t.Fatalf("epoch closed without claim: %v", err)
+	}
+
+	if err := backend.SubmitClaim(ctx, epochHash, 0, 2); err != nil {
+		t.Fatalf("failed to submit claim: %v", err)
+	}
+	closed, err = client.EpochClosed(ctx, 2)
+	if err != nil || !closed {
+		t.Fatalf("epoch not closed after claim: %v", err)
+	}
+	err = client.ExecuteVoucher(ctx, signer, backend.ERC20Token, payload, proof, nil)
+	if err != nil {
+		t.Fatalf("failed to execute voucher: %v", err)
+	}
+	executed, err := client.VoucherExecuted(ctx, 2, 0)
+	if err != nil || !executed {
+		t.Fatalf("voucher not executed: %v", err)
+	}
+	token, _ := bindings.NewTestERC20(backend.ERC20Token, backend.Client())
+	balance, err = token.BalanceOf(nil, recipient.Account())
+	if err != nil || balance.Int64() != 100 {
+		t.Fatalf("wrong recipient balance: %v %v", balance, err)
+	}
+
+	// The voucher can't be executed twice
+	err = client.ExecuteVoucher(ctx, signer, backend.ERC20Token, payload, proof, nil)
+	if err == nil {
+		t.Fatalf("executed voucher twice")
+	}
+	var revertErr *eggeth.RevertError
+	if !errors.As(err, &revertErr) || revertErr.Name != "VoucherReexecutionNotAllowed" {
+		t.Fatalf("wrong error: %v", err)
+	}
+}
+
+// Build the proof of the only voucher of the given input in an epoch that starts at the
+// input 0 and doesn't have notices. Return the proof and the epoch hash.
+func makeVoucherProof(destination common.Address, payload []byte, inputIndex uint64,
+	claimIndex int64) (*bindings.Proof, common.Hash) {
+
+	// Root of each pristine tree with 2^log2Size bytes; the leaves are 8-byte words.
+	pristine := []common.Hash{3: crypto.Keccak256Hash(make([]byte, 8))}
+	for log2Size := 4; log2Size <= 37; log2Size++ {
+		pristine = append(pristine,
+			crypto.Keccak256Hash(pristine[log2Size-1][:], pristine[log2Size-1][:]))
+	}
+	siblings := func(log2Size int) [][32]byte {
+		var result [][32]byte
+		for i := 5; i < log2Size; i++ {
+			result = append(result, pristine[i])
+		}
+		return result
+	}
+	replace := func(position uint64, node common.Hash, siblings [][32]byte) common.Hash {
+		for i, sibling := range siblings {
+			if position&(uint64(32)<<i) == 0 {
+				node = crypto.Keccak256Hash(node[:], sibling[:])
+			} else {
+				node = crypto.Keccak256Hash(sibling[:], node[:])
+			}
+		}
+		return node
+	}
+
+	addressType, _ := abi.NewType("address", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	voucher, _ := abi.Arguments{{Type: addressType}, {Type: bytesType}}.Pack(destination, payload)
+	voucherHash := crypto.Keccak256(voucher)
+	var words [4]common.Hash
+	for i := range words {
+		words[i] = crypto.Keccak256Hash(voucherHash[i*8 : (i+1)*8])
+	}
+	leaf := crypto.Keccak256Hash(
+		crypto.Keccak256(words[0][:], words[1][:]), crypto.Keccak256(words[2][:], words[3][:]))
+
+	var validity bindings.OutputValidityProof
+	validity.InputIndexWithinEpoch = inputIndex
+	validity.OutputIndexWithinInput = 0
+	validity.OutputHashInOutputHashesSiblings = siblings(21)
+	validity.OutputHashesRootHash = replace(0, leaf, validity.OutputHashInOutputHashesSiblings)
+	validity.OutputHashesInEpochSiblings = siblings(37)
+	validity.VouchersEpochRootHash = replace(
+		inputIndex<<5, validity.OutputHashesRootHash, validity.OutputHashesInEpochSiblings)
+	validity.NoticesEpochRootHash = pristine[37]
+	epochHash := crypto.Keccak256Hash(validity.VouchersEpochRootHash[:],
+		validity.NoticesEpochRootHash[:], validity.MachineStateHash[:])
+	proof := &bindings.Proof{
+		Validity: validity,
+		Context:  common.BigToHash(big.NewInt(claimIndex)).Bytes(),
+	}
+	return proof, epochHash
+}
@@ -5,11 +5,11 @@ package eggeth
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -22,73 +22,297 @@ type Signer interface {
 	Account() common.Address
 }
 
+// Options for the blockchain transactions.
+type TxOptions struct {
+
+	// Gas limit of the transaction; if zero, estimate the gas.
+	GasLimit uint64
+
+	// Multiplier applied to the estimated gas as a safety margin; if zero, use
+	// DefaultGasMultiplier. Values below one are treated as one.
+	GasMultiplier float64
+
+	// Maximum fee per gas of EIP-1559 transactions; if nil, use twice the base fee
+	// plus the tip.
+	GasFeeCap *big.Int
+
+	// Maximum tip per gas of EIP-1559 transactions; if nil, use the suggested tip.
+	GasTipCap *big.Int
+
+	// Gas price of legacy transactions; if nil, use the suggested gas price.
+	GasPrice *big.Int
+
+	// Send legacy transactions even if the chain supports EIP-1559.
+	Legacy bool
+
+	// Include the output of debug_traceTransaction in the RevertError when the
+	// transaction fails. This requires the debug namespace in the node.
+	TraceOnRevert bool
+
+	// Number of blocks built on top of the transaction block before the transaction is
+	// considered final. After that, the receipt is checked against the canonical chain;
+	// if a reorg moved the transaction, wait for the confirmations again.
+	Confirmations uint64
+}
+
+// Create the default transaction options.
+func MakeTxOptions() TxOptions {
+	return TxOptions{
+		GasMultiplier: DefaultGasMultiplier,
+	}
+}
+
+// Interval between requests when waiting for the confirmations of a transaction.
+var confirmationPollInterval = time.Second
+
+// Time the node may not know the transaction before it is considered dropped.
+// Nodes behind load balancers may not see a transaction right after it is sent.
+var droppedGracePeriod = 30 * time.Second
+
+// Maximum number of attempts to send a transaction when the node rejects its nonce
+// or drops it.
+const maxSendAttempts = 5
+
+// Error returned when the node doesn't know the transaction after the grace period and
+// its nonce wasn't used by any mined transaction, so it was dropped from the mempool.
+type transactionDropped struct {
+	hash common.Hash
+}
+
+func (e transactionDropped) Error() string {
+	return fmt.Sprintf("transaction %v dropped", e.hash)
+}
+
 // Prepare the transaction, send it, and wait for the receipt.
+// If the transaction is dropped, send it again.
 func sendTransaction(
 	ctx context.Context,
 	client *ethclient.Client,
+	nonces *nonceManager,
 	signer Signer,
 	txValue *big.Int,
-	gasLimit uint64,
+	opts TxOptions,
 	doSend func(txOpts *bind.TransactOpts) (*types.Transaction, error),
 ) (*types.Receipt, error) {
 
-	txOpts, err := _prepareTransaction(ctx, client, signer, txValue, gasLimit)
+	for attempt := 1; ; attempt++ {
+		tx, err := _submitTransaction(ctx, client, nonces, signer, txValue, opts, doSend)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := _waitForTransaction(ctx, client, tx, opts.TraceOnRevert)
+		if err == nil && opts.Confirmations > 0 {
+			receipt, err = _waitForConfirmations(
+				ctx, client, tx, receipt, opts.Confirmations, opts.TraceOnRevert)
+		}
+		if _, ok := err.(transactionDropped); ok && attempt < maxSendAttempts {
+			nonces.reset(signer.Account())
+			continue
+		}
+		return receipt, err
+	}
+}
+
+// Prepare the transaction and send it without waiting for the receipt.
+// If the node rejects the nonce, fetch it again and retry.
+func _submitTransaction(
+	ctx context.Context,
+	client *ethclient.Client,
+	nonces *nonceManager,
+	signer Signer,
+	txValue *big.Int,
+	opts TxOptions,
+	doSend func(txOpts *bind.TransactOpts) (*types.Transaction, error),
+) (*types.Transaction, error) {
+
+	txOpts, err := _prepareTransaction(ctx, client, signer, txValue, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare transaction: %v", err)
 	}
-	tx, err := doSend(txOpts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send dapp address: %v", err)
+	if txOpts.GasLimit == 0 {
+		txOpts.GasLimit, err = _estimateGas(ctx, client, txOpts, opts, doSend)
+		if err != nil {
+			return nil, err
+		}
 	}
-	receipt, err := _waitForTransaction(ctx, client, tx)
-	if err != nil {
-		return nil, err
+	// Keep the signed transaction, since the binding doesn't return it when sending fails.
+	var signedTx *types.Transaction
+	sign := txOpts.Signer
+	txOpts.Signer = func(account common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		signed, err := sign(account, tx)
+		signedTx = signed
+		return signed, err
+	}
+	for attempt := 1; ; attempt++ {
+		nonce, err := nonces.next(ctx, client, signer.Account())
+		if err != nil {
+			return nil, err
+		}
+		txOpts.Nonce = new(big.Int).SetUint64(nonce)
+		signedTx = nil
+		tx, err := doSend(txOpts)
+		if err == nil {
+			return tx, nil
+		}
+		// The node already has the same signed transaction, so sending it again would
+		// send a duplicate with another nonce.
+		if isAlreadyKnown(err) && signedTx != nil {
+			return signedTx, nil
+		}
+		// The nonce wasn't used, so the next one must be fetched from the node.
+		nonces.reset(signer.Account())
+		if !isNonceError(err) || attempt >= maxSendAttempts {
+			return nil, fmt.Errorf("failed to send transaction: %v", err)
+		}
 	}
-	return receipt, nil
 }
 
-// Prepare the blockchain transaction.
+// Prepare the blockchain transaction; the nonce is set when sending it.
+// Use EIP-1559 fees if the chain supports them; otherwise, use the legacy gas price.
 func _prepareTransaction(
 	ctx context.Context,
 	client *ethclient.Client,
 	signer Signer,
 	txValue *big.Int,
-	gasLimit uint64,
+	opts TxOptions,
 ) (*bind.TransactOpts, error) {
 
-	nonce, err := client.PendingNonceAt(ctx, signer.Account())
-	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %v", err)
-	}
-	gasPrice, err := client.SuggestGasPrice(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %v", err)
-	}
 	tx, err := signer.MakeTransactor()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create transactor: %v", err)
 	}
-	tx.Nonce = big.NewInt(int64(nonce))
+	tx.Context = ctx
 	tx.Value = txValue
-	tx.GasLimit = gasLimit
-	tx.GasPrice = gasPrice
+	tx.GasLimit = opts.GasLimit
+
+	if !opts.Legacy {
+		feeCap, tipCap, err := _suggestDynamicFees(ctx, client, opts)
+		if err != nil {
+			return nil, err
+		}
+		if feeCap != nil {
+			tx.GasFeeCap = feeCap
+			tx.GasTipCap = tipCap
+			return tx, nil
+		}
+	}
+
+	tx.GasPrice = opts.GasPrice
+	if tx.GasPrice == nil {
+		tx.GasPrice, err = client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gas price: %v", err)
+		}
+	}
 	return tx, nil
 }
 
+// Suggest the fee cap and tip cap for EIP-1559 transactions.
+// Return nil if the chain doesn't support EIP-1559.
+func _suggestDynamicFees(ctx context.Context, client *ethclient.Client, opts TxOptions) (
+	feeCap *big.Int, tipCap *big.Int, err error) {
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get latest header: %v", err)
+	}
+	if header.BaseFee == nil {
+		return nil, nil, nil
+	}
+	tipCap = opts.GasTipCap
+	if tipCap == nil {
+		tipCap, err = client.SuggestGasTipCap(ctx)
+		if err != nil {
+			// The node doesn't support eth_maxPriorityFeePerGas
+			return nil, nil, nil
+		}
+	}
+	feeCap = opts.GasFeeCap
+	if feeCap == nil {
+		feeCap = new(big.Int).Mul(header.BaseFee, big.NewInt(2))
+		feeCap.Add(feeCap, tipCap)
+	}
+	if feeCap.Cmp(tipCap) < 0 {
+		return nil, nil, fmt.Errorf("fee cap (%v) is lower than tip cap (%v)", feeCap, tipCap)
+	}
+	return feeCap, tipCap, nil
+}
+
+// Estimate the gas of the transaction and apply the safety multiplier.
+// To obtain the transaction data, this function calls doSend without sending nor
+// signing the transaction.
+func _estimateGas(
+	ctx context.Context,
+	client *ethclient.Client,
+	txOpts *bind.TransactOpts,
+	opts TxOptions,
+	doSend func(txOpts *bind.TransactOpts) (*types.Transaction, error),
+) (uint64, error) {
+
+	dryOpts := *txOpts
+	dryOpts.NoSend = true
+	dryOpts.Nonce = big.NewInt(0) // avoid fetching the nonce in the binding
+	dryOpts.GasLimit = 1          // skip the estimation in the binding
+	dryOpts.Signer = func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		return tx, nil
+	}
+	tx, err := doSend(&dryOpts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build transaction: %v", err)
+	}
+	msg := ethereum.CallMsg{
+		From:      txOpts.From,
+		To:        tx.To(),
+		GasPrice:  txOpts.GasPrice,
+		GasFeeCap: txOpts.GasFeeCap,
+		GasTipCap: txOpts.GasTipCap,
+		Value:     tx.Value(),
+		Data:      tx.Data(),
+	}
+	gas, err := client.EstimateGas(ctx, msg)
+	if err != nil {
+		if data, ok := revertData(err); ok {
+			return 0, decodeRevert(common.Hash{}, data)
+		}
+		return 0, fmt.Errorf("failed to estimate gas: %v", err)
+	}
+	multiplier := opts.GasMultiplier
+	if multiplier == 0 {
+		multiplier = DefaultGasMultiplier
+	} else if multiplier < 1 {
+		multiplier = 1
+	}
+	return uint64(float64(gas) * multiplier), nil
+}
+
 // Wait for transaction to be included in a block.
-// Return the transaction receipt.
+// Return the transaction receipt, or a RevertError if the transaction failed.
 func _waitForTransaction(
 	ctx context.Context,
 	client *ethclient.Client,
 	tx *types.Transaction,
+	trace bool,
 ) (*types.Receipt, error) {
 
+	var notFoundSince time.Time
 	for {
 		_, isPending, err := client.TransactionByHash(ctx, tx.Hash())
-		if err != nil {
+		if err == ethereum.NotFound {
+			if notFoundSince.IsZero() {
+				notFoundSince = time.Now()
+			}
+			if time.Since(notFoundSince) >= droppedGracePeriod {
+				dropped, err := _isTransactionDropped(ctx, client, tx)
+				if err != nil {
+					return nil, err
+				}
+				if dropped {
+					return nil, transactionDropped{tx.Hash()}
+				}
+			}
+		} else if err != nil {
 			return nil, fmt.Errorf("fail to recover transaction: %v", err)
-		}
-		if !isPending {
+		} else if !isPending {
 			break
 		}
 		select {
@@ -102,28 +326,85 @@ func _waitForTransaction(
 	if err != nil {
 		return nil, fmt.Errorf("failed to get receipt: %v", err)
 	}
-	if receipt.Status == 0 {
-		reason, err := _traceTransaction(ctx, client, tx.Hash())
-		if err != nil {
-			return nil, fmt.Errorf("transaction failed; failed to get reason: %v", err)
-		}
-		return nil, fmt.Errorf("transaction failed: %v", reason)
+	if receipt.Status == types.ReceiptStatusFailed {
+		return nil, _replayTransaction(ctx, client, tx, receipt, trace)
 	}
 	return receipt, err
 }
 
-func _traceTransaction(
+// Check whether the transaction nonce is still unused in the latest block.
+// If it was used, the transaction was either mined or replaced, so sending it again
+// would spend a new nonce; in this case, keep waiting for the original hash.
+func _isTransactionDropped(
 	ctx context.Context,
 	client *ethclient.Client,
-	hash common.Hash,
-) (string, error) {
-	// We make a call using the rpc client directly because this function
-	// is not present in the ethclient struct. More details in:
-	// https://github.com/ethereum/go-ethereum/issues/17341
-	var result json.RawMessage
-	err := client.Client().Call(&result, "debug_traceTransaction", hash)
+	tx *types.Transaction,
+) (bool, error) {
+
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
 	if err != nil {
-		return "", err
+		return false, fmt.Errorf("failed to recover sender: %v", err)
+	}
+	nonce, err := client.NonceAt(ctx, sender, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get nonce: %v", err)
+	}
+	return nonce <= tx.Nonce(), nil
+}
+
+// Wait until the transaction block has the given number of confirmations.
+// Then, check the receipt against the canonical chain. If a reorg removed the transaction
+// from its block, wait for it to be included again and restart the count.
+// Return the receipt in the canonical chain.
+func _waitForConfirmations(
+	ctx context.Context,
+	client *ethclient.Client,
+	tx *types.Transaction,
+	receipt *types.Receipt,
+	confirmations uint64,
+	trace bool,
+) (*types.Receipt, error) {
+
+	for {
+		head, err := client.BlockNumber(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block number: %v", err)
+		}
+		if head >= receipt.BlockNumber.Uint64()+confirmations {
+			current, err := client.TransactionReceipt(ctx, tx.Hash())
+			if err == ethereum.NotFound {
+				// The transaction left the canonical chain; wait for it to be included again.
+				receipt, err = _waitForTransaction(ctx, client, tx, trace)
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get receipt: %v", err)
+			}
+			header, err := client.HeaderByNumber(ctx, current.BlockNumber)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get header: %v", err)
+			}
+			// If the hashes differ, the node is in the middle of a reorg; check again later.
+			if header.Hash() == current.BlockHash {
+				if current.BlockHash == receipt.BlockHash {
+					return current, nil
+				}
+				// The transaction moved to another block; restart the count.
+				if current.Status == types.ReceiptStatusFailed {
+					return nil, _replayTransaction(ctx, client, tx, current, trace)
+				}
+				receipt = current
+				continue
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(confirmationPollInterval):
+			continue
+		}
 	}
-	return string(result), nil
 }
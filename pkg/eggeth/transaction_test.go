@@ -0,0 +1,311 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggeth
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Make a block header for the stub node.
+func makeStubHeader(baseFee *big.Int) map[string]any {
+	header := &types.Header{
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(1),
+		GasLimit:   30_000_000,
+		BaseFee:    baseFee,
+	}
+	var result map[string]any
+	data, _ := json.Marshal(header)
+	_ = json.Unmarshal(data, &result)
+	return result
+}
+
+// Start a stub Ethereum node that replies with the given results.
+// A result may be a function, which is called for each request, or an error.
+// A function may also receive the request params.
+func setupStubNode(t *testing.T, results map[string]any) *ethclient.Client {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var request struct {
+				ID     json.RawMessage   `json:"id"`
+				Method string            `json:"method"`
+				Params []json.RawMessage `json:"params"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			response := map[string]any{
+				"jsonrpc": "2.0",
+				"id":      request.ID,
+			}
+			result, ok := results[request.Method]
+			if f, isFunc := result.(func() any); isFunc {
+				result = f()
+			}
+			if f, isFunc := result.(func([]json.RawMessage) any); isFunc {
+				result = f(request.Params)
+			}
+			if err, isErr := result.(error); isErr {
+				rpcErr := map[string]any{
+					"code":    -32000,
+					"message": err.Error(),
+				}
+				if dataErr, isDataErr := err.(rpc.DataError); isDataErr {
+					rpcErr["code"] = 3
+					rpcErr["data"] = dataErr.ErrorData()
+				}
+				response["error"] = rpcErr
+			} else if ok {
+				response["result"] = result
+			} else {
+				response["error"] = map[string]any{
+					"code":    -32601,
+					"message": "method not found",
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(response)
+		},
+	))
+	t.Cleanup(server.Close)
+	client, err := ethclient.Dial(server.URL)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	return client
+}
+
+func TestPrepareDynamicFeeTransaction(t *testing.T) {
+	client := setupStubNode(t, map[string]any{
+		"eth_getBlockByNumber":     makeStubHeader(big.NewInt(100)),
+		"eth_maxPriorityFeePerGas": "0xa",
+		"eth_gasPrice":             "0x3e8",
+	})
+	signer, _ := NewPrivateKeySignerFromHex(testPrivateKey, big.NewInt(31337))
+	ctx := context.Background()
+
+	txOpts, err := _prepareTransaction(ctx, client, signer, big.NewInt(0), MakeTxOptions())
+	if err != nil {
+		t.Fatalf("failed to prepare: %v", err)
+	}
+	if txOpts.GasPrice != nil {
+		t.Fatalf("expected nil gas price; got %v", txOpts.GasPrice)
+	}
+	if txOpts.GasTipCap.Int64() != 10 || txOpts.GasFeeCap.Int64() != 210 {
+		t.Fatalf("wrong fees: %v %v", txOpts.GasTipCap, txOpts.GasFeeCap)
+	}
+
+	opts := MakeTxOptions()
+	opts.GasTipCap = big.NewInt(20)
+	opts.GasFeeCap = big.NewInt(500)
+	opts.GasLimit = 100_000
+	txOpts, err = _prepareTransaction(ctx, client, signer, big.NewInt(0), opts)
+	if err != nil {
+		t.Fatalf("failed to prepare: %v", err)
+	}
+	if txOpts.GasTipCap.Int64() != 20 || txOpts.GasFeeCap.Int64() != 500 {
+		t.Fatalf("wrong fees: %v %v", txOpts.GasTipCap, txOpts.GasFeeCap)
+	}
+	if txOpts.GasLimit != 100_000 {
+		t.Fatalf("wrong gas limit: %v", txOpts.GasLimit)
+	}
+
+	opts = MakeTxOptions()
+	opts.Legacy = true
+	txOpts, err = _prepareTransaction(ctx, client, signer, big.NewInt(0), opts)
+	if err != nil {
+		t.Fatalf("failed to prepare: %v", err)
+	}
+	if txOpts.GasPrice.Int64() != 1000 || txOpts.GasFeeCap != nil {
+		t.Fatalf("expected legacy transaction")
+	}
+}
+
+func TestPrepareLegacyTransaction(t *testing.T) {
+	// the chain doesn't support EIP-1559
+	client := setupStubNode(t, map[string]any{
+		"eth_getBlockByNumber": makeStubHeader(nil),
+		"eth_gasPrice":         "0x3e8",
+	})
+	signer, _ := NewPrivateKeySignerFromHex(testPrivateKey, big.NewInt(31337))
+	ctx := context.Background()
+
+	txOpts, err := _prepareTransaction(ctx, client, signer, big.NewInt(0), MakeTxOptions())
+	if err != nil {
+		t.Fatalf("failed to prepare: %v", err)
+	}
+	if txOpts.GasPrice.Int64() != 1000 || txOpts.GasFeeCap != nil {
+		t.Fatalf("expected legacy transaction")
+	}
+
+	opts := MakeTxOptions()
+	opts.GasPrice = big.NewInt(2000)
+	txOpts, err = _prepareTransaction(ctx, client, signer, big.NewInt(0), opts)
+	if err != nil {
+		t.Fatalf("failed to prepare: %v", err)
+	}
+	if txOpts.GasPrice.Int64() != 2000 {
+		t.Fatalf("wrong gas price: %v", txOpts.GasPrice)
+	}
+}
+
+func TestEstimateGas(t *testing.T) {
+	client := setupStubNode(t, map[string]any{
+		"eth_estimateGas": "0x186a0", // 100000
+	})
+	signer, _ := NewPrivateKeySignerFromHex(testPrivateKey, big.NewInt(31337))
+	txOpts, _ := signer.MakeTransactor()
+	txOpts.Nonce = big.NewInt(0)
+	txOpts.GasPrice = big.NewInt(1000)
+
+	to := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	doSend := func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+		if !txOpts.NoSend {
+			t.Fatalf("expected no send")
+		}
+		tx := types.NewTx(&types.LegacyTx{
+			GasPrice: txOpts.GasPrice,
+			Gas:      txOpts.GasLimit,
+			To:       &to,
+			Value:    big.NewInt(0),
+			Data:     common.Hex2Bytes("deadbeef"),
+		})
+		return txOpts.Signer(txOpts.From, tx)
+	}
+	testCases := []struct {
+		opts     TxOptions
+		expected uint64
+	}{
+		{MakeTxOptions(), 120_000},
+		{TxOptions{}, 120_000},
+		{TxOptions{GasMultiplier: 0.5}, 100_000},
+		{TxOptions{GasMultiplier: 2}, 200_000},
+	}
+	for _, tc := range testCases {
+		gas, err := _estimateGas(context.Background(), client, txOpts, tc.opts, doSend)
+		if err != nil {
+			t.Fatalf("failed to estimate: %v", err)
+		}
+		if gas != tc.expected {
+			t.Fatalf("wrong gas with multiplier %v: %v", tc.opts.GasMultiplier, gas)
+		}
+	}
+}
+
+// Make a receipt in the block of the stub header.
+func makeStubReceipt(t *testing.T, tx *types.Transaction, logs ...*types.Log) *types.Receipt {
+	var header types.Header
+	data, _ := json.Marshal(makeStubHeader(big.NewInt(100)))
+	if err := json.Unmarshal(data, &header); err != nil {
+		t.Fatal(err)
+	}
+	return &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      tx.Hash(),
+		BlockHash:   header.Hash(),
+		BlockNumber: header.Number,
+		Logs:        append([]*types.Log{}, logs...),
+	}
+}
+
+// Make the fields of a mined transaction for the stub node.
+func makeStubTransaction(
+	t *testing.T, tx *types.Transaction, receipt *types.Receipt) map[string]any {
+
+	var fields map[string]any
+	data, _ := json.Marshal(tx)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields["from"] = sender
+	fields["blockHash"] = receipt.BlockHash
+	fields["blockNumber"] = (*hexutil.Big)(receipt.BlockNumber)
+	fields["transactionIndex"] = hexutil.Uint64(receipt.TransactionIndex)
+	return fields
+}
+
+func TestWaitForConfirmations(t *testing.T) {
+	confirmationPollInterval = 10 * time.Millisecond
+	tx := types.NewTx(&types.LegacyTx{})
+	receipt := makeStubReceipt(t, tx)
+	reorgedReceipt := *receipt
+	reorgedReceipt.BlockHash = common.HexToHash("0x01")
+	head := "0x3"
+	client := setupStubNode(t, map[string]any{
+		"eth_blockNumber":           func() any { return head },
+		"eth_getBlockByNumber":      makeStubHeader(big.NewInt(100)),
+		"eth_getTransactionReceipt": receipt,
+	})
+	ctx := context.Background()
+
+	// the transaction moved to the block of the stub header
+	confirmed, err := _waitForConfirmations(ctx, client, tx, &reorgedReceipt, 2, false)
+	if err != nil {
+		t.Fatalf("failed to wait: %v", err)
+	}
+	if confirmed.BlockHash != receipt.BlockHash {
+		t.Fatalf("wrong block hash: %v", confirmed.BlockHash)
+	}
+
+	// the block doesn't have enough confirmations
+	head = "0x2"
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = _waitForConfirmations(ctx, client, tx, receipt, 2, false)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded; got %v", err)
+	}
+}
+
+func TestWaitForTransactionNotFound(t *testing.T) {
+	droppedGracePeriod = 0
+	chainId := big.NewInt(31337)
+	privateKey, _ := crypto.HexToECDSA(testPrivateKey)
+	tx, _ := types.SignNewTx(privateKey, types.LatestSignerForChainID(chainId), &types.LegacyTx{
+		Nonce:    5,
+		GasPrice: big.NewInt(1),
+		Gas:      21000,
+		To:       &common.Address{},
+		Value:    big.NewInt(0),
+	})
+	nonce := "0x5"
+	client := setupStubNode(t, map[string]any{
+		"eth_getTransactionByHash": nil,
+		"eth_getTransactionCount":  func() any { return nonce },
+	})
+	ctx := context.Background()
+
+	// the nonce wasn't used, so the transaction was dropped
+	_, err := _waitForTransaction(ctx, client, tx, false)
+	if _, ok := err.(transactionDropped); !ok {
+		t.Fatalf("expected transaction dropped; got %v", err)
+	}
+
+	// the nonce was used, so keep waiting for the original transaction
+	nonce = "0x6"
+	ctx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+	_, err = _waitForTransaction(ctx, client, tx, false)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded; got %v", err)
+	}
+}
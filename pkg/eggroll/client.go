@@ -9,39 +9,72 @@ import (
 	"time"
 
 	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
 	"github.com/gligneul/eggroll/pkg/eggtypes"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gligneul/eggroll/internal/reader"
-	"github.com/gligneul/eggroll/internal/sunodo"
 )
 
+// Error returned when the proof of an output isn't available because the
+// epoch of the output isn't finalized yet.
+type EpochNotFinalized struct {
+	InputIndex  int
+	OutputIndex int
+}
+
+func (e EpochNotFinalized) Error() string {
+	return fmt.Sprintf("epoch of output %v of input %v not finalized",
+		e.OutputIndex, e.InputIndex)
+}
+
 // Configuration for the client struct.
 type ClientConfig struct {
 	DAppAddress      common.Address
 	GraphqlEndpoint  string
 	InspectEndpoint  string
 	ProviderEndpoint string
+
+	// Deployment of the Cartesi Rollups contracts; if nil, use the localhost one.
+	Deployment *eggeth.Deployment
+}
+
+// The reader client reads the DApp state from the rollups node.
+// It doesn't connect to the Ethereum node, so it can't send inputs or execute outputs.
+type ReaderClient struct {
+	reader  *reader.GraphQLReader
+	inspect *reader.InspectClient
+}
+
+// Create a new reader client with the rollups node endpoints of the config.
+func NewReaderClient(config ClientConfig) *ReaderClient {
+	return &ReaderClient{
+		reader:  reader.NewGraphQLReader(config.GraphqlEndpoint),
+		inspect: reader.NewInspectClient(config.InspectEndpoint),
+	}
 }
 
 // The client interacts with the DApp contract off-chain.
 type Client struct {
 	ClientConfig
-	reader  *reader.GraphQLReader
-	inspect *reader.InspectClient
-	Eth     *eggeth.ETHClient
+	*ReaderClient
+	Eth *eggeth.ETHClient
 }
 
 // Create a new client with the given config.
 func NewClient(config ClientConfig) (*Client, error) {
-	ethClient, err := eggeth.NewETHClient(config.ProviderEndpoint, config.DAppAddress)
+	deployment := eggeth.LocalhostDeployment()
+	if config.Deployment != nil {
+		deployment = *config.Deployment
+	}
+	ethClient, err := eggeth.NewETHClientWithDeployment(
+		config.ProviderEndpoint, config.DAppAddress, deployment)
 	if err != nil {
 		return nil, err
 	}
 	client := &Client{
 		ClientConfig: config,
-		reader:       reader.NewGraphQLReader(config.GraphqlEndpoint),
-		inspect:      reader.NewInspectClient(config.InspectEndpoint),
+		ReaderClient: NewReaderClient(config),
 		Eth:          ethClient,
 	}
 	return client, nil
@@ -51,16 +84,19 @@ func NewClient(config ClientConfig) (*Client, error) {
 // Connects to the Rollups Node and the Ethereum Node setup by sunodo.
 // Return a signer that uses the Foundry's test mnemonic to send transactions.
 func NewDevClient(ctx context.Context) (*Client, eggeth.Signer, error) {
-	dappAddress, err := sunodo.GetDAppAddress()
+	profile := MakeDevProfile()
+	config, err := profile.ClientConfig()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get DApp address: %v", err)
-	}
-	config := ClientConfig{
-		DAppAddress:      dappAddress,
-		GraphqlEndpoint:  "http://localhost:8080/graphql",
-		InspectEndpoint:  "http://localhost:8080/inspect",
-		ProviderEndpoint: "ws://localhost:8545",
+		return nil, nil, err
 	}
+	return NewClientWithSigner(ctx, config, eggeth.MakeSignerConfig())
+}
+
+// Create a new client with the given config.
+// Return the signer described by the signer config for the chain of the provider.
+func NewClientWithSigner(ctx context.Context, config ClientConfig, signerConfig eggeth.SignerConfig) (
+	*Client, eggeth.Signer, error) {
+
 	client, err := NewClient(config)
 	if err != nil {
 		return nil, nil, err
@@ -69,7 +105,7 @@ func NewDevClient(ctx context.Context) (*Client, eggeth.Signer, error) {
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get chain id: %v", err)
 	}
-	signer, err := eggeth.NewMnemonicSigner(eggeth.FoundryMnemonic, 0, chainId)
+	signer, err := eggeth.NewSigner(signerConfig, chainId)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create signer: %v", err)
 	}
@@ -80,9 +116,60 @@ func NewDevClient(ctx context.Context) (*Client, eggeth.Signer, error) {
 // Reader functions
 //
 
+// Options for WaitFor.
+type WaitForOpts struct {
+
+	// Interval between requests when waiting for the input.
+	PollInterval time.Duration
+
+	// Also wait until the epoch of the input is closed, which happens when the DApp
+	// consensus receives a claim that includes the input.
+	WaitForEpoch bool
+}
+
+// Return the default values for the options.
+func MakeWaitForOpts() WaitForOpts {
+	return WaitForOpts{
+		PollInterval: 100 * time.Millisecond,
+	}
+}
+
 // Wait until the DApp contract processes a given input.
 // Returns the advance result of that input.
 func (c *Client) WaitFor(ctx context.Context, inputIndex int) (*eggtypes.AdvanceResult, error) {
+	return c.WaitForWithOpts(ctx, inputIndex, MakeWaitForOpts())
+}
+
+// Wait until the DApp contract processes a given input with the given options.
+// Returns the advance result of that input.
+func (c *Client) WaitForWithOpts(
+	ctx context.Context, inputIndex int, opts WaitForOpts) (*eggtypes.AdvanceResult, error) {
+
+	result, err := c.waitForResult(ctx, inputIndex, opts)
+	if err != nil || !opts.WaitForEpoch {
+		return result, err
+	}
+	for {
+		closed, err := c.Eth.EpochClosed(ctx, inputIndex)
+		if err != nil {
+			return nil, err
+		}
+		if closed {
+			return result, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(opts.PollInterval):
+			continue
+		}
+	}
+}
+
+// Wait until the rollups node processes the input.
+func (c *ReaderClient) waitForResult(
+	ctx context.Context, inputIndex int, opts WaitForOpts) (*eggtypes.AdvanceResult, error) {
+
 	for {
 		result, err := c.reader.AdvanceResult(ctx, inputIndex)
 		if err != nil {
@@ -98,35 +185,356 @@ func (c *Client) WaitFor(ctx context.Context, inputIndex int) (*eggtypes.Advance
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
-		case <-time.After(100 * time.Millisecond):
+		case <-time.After(opts.PollInterval):
 			continue
 		}
 	}
 }
 
-// Get the results starting from the given input index.
-// func (c *Client) GetResults(ctx context.Context, inputIndex int) (
-// 	[]*eggtypes.AdvanceResult, error) {
-//
-// 	var results []*eggtypes.AdvanceResult
-// 	for {
-// 		result, err := c.reader.AdvanceResult(ctx, inputIndex)
-// 		if err != nil {
-// 			if _, ok := err.(reader.NotFound); ok {
-// 				break
-// 			}
-// 			return nil, fmt.Errorf("failed to get result: %v", err)
-// 		}
-// 		if result.Status == eggtypes.CompletionStatusUnprocessed {
-// 			break
-// 		}
-// 		results = append(results, result)
-// 		inputIndex++
-// 	}
-// 	return results, nil
-// }
+// Wait until the proof of the given voucher is available.
+// The proof is available after the epoch of the voucher is finalized.
+// Returns the voucher with the proof.
+func (c *ReaderClient) WaitForVoucherProof(
+	ctx context.Context, inputIndex int, outputIndex int) (*eggtypes.Voucher, error) {
+
+	return c.WaitForVoucherProofWithOpts(ctx, inputIndex, outputIndex, MakeWaitForOpts())
+}
+
+// Wait until the proof of the given voucher is available with the given options.
+// Only opts.PollInterval applies to proofs; opts.WaitForEpoch is ignored.
+// Returns the voucher with the proof.
+func (c *ReaderClient) WaitForVoucherProofWithOpts(ctx context.Context,
+	inputIndex int, outputIndex int, opts WaitForOpts) (*eggtypes.Voucher, error) {
+
+	for {
+		voucher, err := c.reader.Voucher(ctx, inputIndex, outputIndex)
+		if err != nil {
+			if _, ok := err.(reader.NotFound); ok {
+				goto wait
+			}
+			return nil, fmt.Errorf("failed to read voucher: %v", err)
+		}
+		if voucher.Proof != nil {
+			return voucher, nil
+		}
+	wait:
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(opts.PollInterval):
+			continue
+		}
+	}
+}
 
 // Send an inspect request.
-func (c *Client) Inspect(ctx context.Context, payload []byte) (*eggtypes.InspectResult, error) {
+func (c *ReaderClient) Inspect(ctx context.Context, payload []byte) (*eggtypes.InspectResult, error) {
 	return c.inspect.Inspect(ctx, payload)
 }
+
+//
+// History functions
+//
+
+// Options for the history queries.
+type QueryOpts struct {
+
+	// Number of entries fetched from the rollups node in each request.
+	PageSize int
+
+	// If set, only return the entries related to inputs sent by this address.
+	// The rollups node can't filter by sender, so the client fetches every entry and
+	// filters them; the cost of the query grows with the total number of entries.
+	Sender *common.Address
+
+	// Only return the entries related to inputs with index greater or equal to this value.
+	// The rollups node only filters the inputs by index; the outputs are filtered by
+	// the client.
+	FromInput int
+
+	// If non-negative, only return the entries related to inputs with index lower or equal
+	// to this value.
+	ToInput int
+}
+
+// Return the default values for the options.
+func MakeQueryOpts() QueryOpts {
+	return QueryOpts{
+		PageSize:  100,
+		Sender:    nil,
+		FromInput: 0,
+		ToInput:   -1,
+	}
+}
+
+// Iterate over the results of a paginated query.
+// The iterator fetches the pages from the rollups node on demand.
+type Iterator[T any] struct {
+	ctx    context.Context
+	fetch  func(ctx context.Context) ([]T, bool, error)
+	values []T
+	value  T
+	done   bool
+	err    error
+}
+
+// Advance the iterator to the next value.
+// Return false when there are no more values or when an error happens.
+func (it *Iterator[T]) Next() bool {
+	for len(it.values) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		var hasMore bool
+		it.values, hasMore, it.err = it.fetch(it.ctx)
+		it.done = !hasMore
+	}
+	it.value = it.values[0]
+	it.values = it.values[1:]
+	return true
+}
+
+// Get the current value of the iterator.
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Return the error that stopped the iterator, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Paginated query of the reader.
+type pageQuery[T any] func(ctx context.Context, first int, after *string,
+	filter reader.InputsFilter) (*reader.Page[T], error)
+
+// Create an iterator over the pages of the reader query.
+func newPageIterator[T any](ctx context.Context, opts QueryOpts, query pageQuery[T]) *Iterator[T] {
+	filter := reader.InputsFilter{
+		Sender:    opts.Sender,
+		FromInput: opts.FromInput,
+		ToInput:   opts.ToInput,
+	}
+	var cursor *string
+	fetch := func(ctx context.Context) ([]T, bool, error) {
+		page, err := query(ctx, opts.PageSize, cursor, filter)
+		if err != nil {
+			return nil, false, err
+		}
+		cursor = page.EndCursor
+		return page.Results, page.HasNextPage, nil
+	}
+	return &Iterator[T]{ctx: ctx, fetch: fetch}
+}
+
+// Iterate over the advance results of the inputs that match the options.
+// Each result has all the outputs of the input.
+func (c *ReaderClient) Inputs(ctx context.Context, opts QueryOpts) *Iterator[*eggtypes.AdvanceResult] {
+	return newPageIterator(ctx, opts, c.reader.Inputs)
+}
+
+// Iterate over the vouchers of the inputs that match the options.
+// The vouchers don't have proofs; use WaitForVoucherProof to get them.
+func (c *ReaderClient) Vouchers(ctx context.Context, opts QueryOpts) *Iterator[eggtypes.Voucher] {
+	return newPageIterator(ctx, opts, c.reader.Vouchers)
+}
+
+// Iterate over the notices of the inputs that match the options.
+// The notices don't have proofs; ValidateNotice gets them when necessary.
+func (c *ReaderClient) Notices(ctx context.Context, opts QueryOpts) *Iterator[eggtypes.Notice] {
+	return newPageIterator(ctx, opts, c.reader.Notices)
+}
+
+// Iterate over the reports of the inputs that match the options.
+func (c *ReaderClient) Reports(ctx context.Context, opts QueryOpts) *Iterator[eggtypes.Report] {
+	return newPageIterator(ctx, opts, c.reader.Reports)
+}
+
+//
+// Subscription functions
+//
+
+// Options for the subscription.
+type SubscribeOpts struct {
+
+	// Interval between requests when waiting for new inputs.
+	PollInterval time.Duration
+
+	// Number of consecutive failed requests before the subscription stops.
+	MaxRetries int
+}
+
+// Return the default values for the options.
+func MakeSubscribeOpts() SubscribeOpts {
+	return SubscribeOpts{
+		PollInterval: 500 * time.Millisecond,
+		MaxRetries:   5,
+	}
+}
+
+// Subscription to the results of the processed inputs.
+type Subscription struct {
+	results chan *eggtypes.AdvanceResult
+	err     error
+}
+
+// Get the channel that yields the result of each processed input in order.
+// The channel is closed when the subscription stops.
+func (s *Subscription) Results() <-chan *eggtypes.AdvanceResult {
+	return s.results
+}
+
+// Return the error that stopped the subscription.
+// This should be called after the results channel is closed.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Subscribe to the results of the processed inputs, starting from the given index.
+// The subscription stops when the context is canceled.
+func (c *ReaderClient) Subscribe(ctx context.Context, fromIndex int) *Subscription {
+	return c.SubscribeWithOpts(ctx, fromIndex, MakeSubscribeOpts())
+}
+
+// Subscribe to the results of the processed inputs with the given options.
+// The subscription stops when the context is canceled or when the requests to the
+// rollups node fail more than opts.MaxRetries times in a row.
+func (c *ReaderClient) SubscribeWithOpts(
+	ctx context.Context, fromIndex int, opts SubscribeOpts) *Subscription {
+
+	sub := &Subscription{
+		results: make(chan *eggtypes.AdvanceResult),
+	}
+	go func() {
+		defer close(sub.results)
+		sub.err = c.subscribe(ctx, fromIndex, opts, sub.results)
+	}()
+	return sub
+}
+
+// Send the results of the processed inputs to the channel until an error happens.
+func (c *ReaderClient) subscribe(ctx context.Context, nextIndex int, opts SubscribeOpts,
+	results chan<- *eggtypes.AdvanceResult) error {
+
+	const pageSize = 100
+	failures := 0
+	backoff := opts.PollInterval
+	for {
+		filter := reader.InputsFilter{
+			FromInput: nextIndex,
+			ToInput:   -1,
+		}
+		page, err := c.reader.Inputs(ctx, pageSize, nil, filter)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			failures++
+			if failures > opts.MaxRetries {
+				return fmt.Errorf("failed to read inputs: %v", err)
+			}
+			// wait longer after each failure
+			backoff *= 2
+			goto wait
+		}
+		failures = 0
+		backoff = opts.PollInterval
+
+		for _, result := range page.Results {
+			// Stop at gaps and unprocessed inputs to send the results in order.
+			if result.Index != nextIndex ||
+				result.Status == eggtypes.CompletionStatusUnprocessed {
+				goto wait
+			}
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			nextIndex++
+		}
+		if page.HasNextPage {
+			continue
+		}
+
+	wait:
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+			continue
+		}
+	}
+}
+
+//
+// Voucher functions
+//
+
+// Execute the voucher in the DApp contract.
+// The voucher must have a proof; use WaitForVoucherProof to get it.
+// This function waits until the transaction is added to a block.
+func (c *Client) ExecuteVoucher(
+	ctx context.Context, signer eggeth.Signer, voucher *eggtypes.Voucher) error {
+
+	if voucher.Proof == nil {
+		return fmt.Errorf("voucher doesn't have proof")
+	}
+	proof := convertProof(voucher.Proof)
+	err := c.Eth.ExecuteVoucher(ctx, signer, voucher.Destination, voucher.Payload, proof, nil)
+	if err != nil {
+		return fmt.Errorf("failed to execute voucher: %v", err)
+	}
+	return nil
+}
+
+// Check whether the voucher was executed in the DApp contract.
+func (c *Client) VoucherExecuted(ctx context.Context, voucher *eggtypes.Voucher) (bool, error) {
+	return c.Eth.VoucherExecuted(ctx, voucher.InputIndex, voucher.OutputIndex)
+}
+
+//
+// Notice functions
+//
+
+// Validate the notice in the DApp contract.
+// If the notice doesn't have a proof, get it from the rollups node.
+// Return EpochNotFinalized if the proof isn't available yet.
+// Return error if the DApp contract rejects the notice.
+func (c *Client) ValidateNotice(ctx context.Context, notice *eggtypes.Notice) error {
+	proof := notice.Proof
+	if proof == nil {
+		provenNotice, err := c.reader.Notice(ctx, notice.InputIndex, notice.OutputIndex)
+		if err != nil {
+			return fmt.Errorf("failed to read notice: %v", err)
+		}
+		if provenNotice.Proof == nil {
+			return EpochNotFinalized{notice.InputIndex, notice.OutputIndex}
+		}
+		proof = provenNotice.Proof
+	}
+	return c.Eth.ValidateNotice(ctx, notice.Payload, convertProof(proof))
+}
+
+// Convert the proof to the format expected by the DApp contract.
+func convertProof(proof *eggtypes.Proof) *bindings.Proof {
+	var validity bindings.OutputValidityProof
+	validity.InputIndexWithinEpoch = proof.InputIndexWithinEpoch
+	validity.OutputIndexWithinInput = proof.OutputIndexWithinInput
+	validity.OutputHashesRootHash = proof.OutputHashesRootHash
+	validity.VouchersEpochRootHash = proof.VouchersEpochRootHash
+	validity.NoticesEpochRootHash = proof.NoticesEpochRootHash
+	validity.MachineStateHash = proof.MachineStateHash
+	for _, sibling := range proof.OutputHashInOutputHashesSiblings {
+		validity.OutputHashInOutputHashesSiblings = append(
+			validity.OutputHashInOutputHashesSiblings, sibling)
+	}
+	for _, sibling := range proof.OutputHashesInEpochSiblings {
+		validity.OutputHashesInEpochSiblings = append(
+			validity.OutputHashesInEpochSiblings, sibling)
+	}
+	return &bindings.Proof{
+		Validity: validity,
+		Context:  proof.Context,
+	}
+}
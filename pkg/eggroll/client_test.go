@@ -0,0 +1,304 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggroll
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestValidateNoticeNotFinalized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"data": {"notice": {"index": 0, "payload": "0x", "proof": null}}}`))
+		},
+	))
+	defer server.Close()
+
+	config := ClientConfig{
+		GraphqlEndpoint:  server.URL,
+		ProviderEndpoint: server.URL,
+	}
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	notice := &eggtypes.Notice{InputIndex: 1, OutputIndex: 0}
+	err = client.ValidateNotice(context.Background(), notice)
+	expectedErr := EpochNotFinalized{InputIndex: 1, OutputIndex: 0}
+	if err != expectedErr {
+		t.Fatalf("expected epoch not finalized; got %v", err)
+	}
+}
+
+// Encode an input node for the fake GraphQL server.
+func makeInputNode(index int, sender string, status string) string {
+	return fmt.Sprintf(`{"node": {
+		"index": %v,
+		"status": "%v",
+		"payload": "0x",
+		"msgSender": "%v",
+		"timestamp": "1000",
+		"blockNumber": "10",
+		"vouchers": {"edges": []},
+		"notices": {"edges": [{"node": {"index": 0, "payload": "0x%02x"}}]},
+		"reports": {"edges": []}
+	}}`, index, status, sender, index)
+}
+
+func TestWaitForVoucherProof(t *testing.T) {
+	var mutex sync.Mutex
+	numRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			numRequests++
+			proof := "null"
+			if numRequests >= 3 {
+				hash := "0x" + strings.Repeat("00", 32)
+				proof = fmt.Sprintf(`{"validity": {
+					"inputIndexWithinEpoch": 0,
+					"outputIndexWithinInput": 0,
+					"outputHashesRootHash": "%[1]v",
+					"vouchersEpochRootHash": "%[1]v",
+					"noticesEpochRootHash": "%[1]v",
+					"machineStateHash": "%[1]v",
+					"outputHashInOutputHashesSiblings": [],
+					"outputHashesInEpochSiblings": []
+				}, "context": "0x"}`, hash)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"data": {"voucher": {
+				"index": 0,
+				"destination": "0xfafafafafafafafafafafafafafafafafafafafa",
+				"payload": "0xdeadbeef",
+				"proof": %v
+			}}}`, proof)
+		},
+	))
+	defer server.Close()
+
+	client := NewReaderClient(ClientConfig{GraphqlEndpoint: server.URL})
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	opts := MakeWaitForOpts()
+	opts.PollInterval = 10 * time.Millisecond
+	voucher, err := client.WaitForVoucherProofWithOpts(ctx, 1, 0, opts)
+	if err != nil {
+		t.Fatalf("failed to wait for proof: %v", err)
+	}
+	if voucher.Proof == nil || voucher.InputIndex != 1 {
+		t.Fatalf("wrong voucher: %+v", voucher)
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if numRequests != 3 {
+		t.Fatalf("wrong number of requests: %v", numRequests)
+	}
+}
+
+// Encode a notice node for the fake GraphQL server.
+func makeNoticeNode(inputIndex int, sender string) string {
+	return fmt.Sprintf(`{"node": {
+		"index": 0,
+		"payload": "0x%02x",
+		"input": {"index": %v, "msgSender": "%v"}
+	}}`, inputIndex, inputIndex, sender)
+}
+
+// Start a fake GraphQL server that returns the inputs or their notices in pages.
+func setupInputsServer(t *testing.T, senders []string) string {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var request struct {
+				OperationName string `json:"operationName"`
+				Variables     struct {
+					First int     `json:"first"`
+					After *string `json:"after"`
+				} `json:"variables"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			start := 0
+			if request.Variables.After != nil {
+				start, _ = strconv.Atoi(*request.Variables.After)
+			}
+			end := min(start+request.Variables.First, len(senders))
+			var edges []string
+			for i := start; i < end; i++ {
+				if request.OperationName == "getNotices" {
+					edges = append(edges, makeNoticeNode(i, senders[i]))
+				} else {
+					edges = append(edges, makeInputNode(i, senders[i], "ACCEPTED"))
+				}
+			}
+			connection := "inputs"
+			if request.OperationName == "getNotices" {
+				connection = "notices"
+			}
+			fmt.Fprintf(w, `{"data": {"%v": {
+				"edges": [%v],
+				"pageInfo": {"endCursor": "%v", "hasNextPage": %v}
+			}}}`, connection, strings.Join(edges, ","), end, end < len(senders))
+		},
+	))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestClientNotices(t *testing.T) {
+	alice := "0xfafafafafafafafafafafafafafafafafafafafa"
+	bob := "0xfefefefefefefefefefefefefefefefefefefefe"
+	endpoint := setupInputsServer(t, []string{alice, bob, bob, alice, bob})
+	client, err := NewClient(ClientConfig{
+		GraphqlEndpoint:  endpoint,
+		ProviderEndpoint: endpoint,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	opts := MakeQueryOpts()
+	opts.PageSize = 2
+	sender := common.HexToAddress(bob)
+	opts.Sender = &sender
+	var indices []int
+	it := client.Notices(context.Background(), opts)
+	for it.Next() {
+		notice := it.Value()
+		if notice.Payload[0] != byte(notice.InputIndex) {
+			t.Fatalf("wrong notice payload: %x", notice.Payload)
+		}
+		indices = append(indices, notice.InputIndex)
+	}
+	if it.Err() != nil {
+		t.Fatalf("expected nil err; got %v", it.Err())
+	}
+	if !reflect.DeepEqual(indices, []int{1, 2, 4}) {
+		t.Fatalf("wrong input indices: %v", indices)
+	}
+}
+
+// Start a fake GraphQL server that processes one more input at each request.
+// The first request fails to exercise the retries.
+func setupSubscriptionServer(t *testing.T, numInputs int) string {
+	var mutex sync.Mutex
+	numRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			numRequests++
+			if numRequests == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			var request struct {
+				Variables struct {
+					Where struct {
+						IndexGreaterThan *int `json:"indexGreaterThan"`
+					} `json:"where"`
+				} `json:"variables"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			start := 0
+			if request.Variables.Where.IndexGreaterThan != nil {
+				start = *request.Variables.Where.IndexGreaterThan + 1
+			}
+			var edges []string
+			for i := start; i < numInputs; i++ {
+				status := "ACCEPTED"
+				if i >= numRequests-1 {
+					status = "UNPROCESSED"
+				}
+				edges = append(edges, makeInputNode(i, "0x0000000000000000000000000000000000000000", status))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"data": {"inputs": {
+				"edges": [%v],
+				"pageInfo": {"endCursor": null, "hasNextPage": false}
+			}}}`, strings.Join(edges, ","))
+		},
+	))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestClientSubscribe(t *testing.T) {
+	endpoint := setupSubscriptionServer(t, 4)
+	client, err := NewClient(ClientConfig{
+		GraphqlEndpoint:  endpoint,
+		ProviderEndpoint: endpoint,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := MakeSubscribeOpts()
+	opts.PollInterval = time.Millisecond
+	sub := client.SubscribeWithOpts(ctx, 1, opts)
+	var indices []int
+	for result := range sub.Results() {
+		indices = append(indices, result.Index)
+		if result.Status != eggtypes.CompletionStatusAccepted {
+			t.Fatalf("wrong status: %v", result.Status)
+		}
+		if len(indices) == 3 {
+			cancel()
+		}
+	}
+	if sub.Err() != context.Canceled {
+		t.Fatalf("expected context canceled; got %v", sub.Err())
+	}
+	if !reflect.DeepEqual(indices, []int{1, 2, 3}) {
+		t.Fatalf("wrong input indices: %v", indices)
+	}
+}
+
+func TestClientSubscribeRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	))
+	defer server.Close()
+	client, err := NewClient(ClientConfig{
+		GraphqlEndpoint:  server.URL,
+		ProviderEndpoint: server.URL,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	opts := MakeSubscribeOpts()
+	opts.PollInterval = time.Millisecond
+	opts.MaxRetries = 2
+	sub := client.SubscribeWithOpts(context.Background(), 0, opts)
+	for range sub.Results() {
+		t.Fatalf("expected no results")
+	}
+	if sub.Err() == nil || !strings.HasPrefix(sub.Err().Error(), "failed to read inputs") {
+		t.Fatalf("wrong error: %v", sub.Err())
+	}
+}
@@ -6,9 +6,12 @@ package eggroll
 
 import (
 	"fmt"
+	"log"
 	"math/big"
+	"runtime/debug"
 
 	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
 	"github.com/gligneul/eggroll/pkg/eggwallets"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -41,10 +44,12 @@ type EnvReader interface {
 	// Call fmt.Sprintf, print the log, and send a report encoded as eggtypes.Error.
 	Errorf(format string, a ...any)
 
-	// Call fmt.Sprint, print the log, send a report encoded as eggtypes.Error, and exit.
+	// Call fmt.Sprint, and abort the input as if the contract panicked.
+	// EggRoll handles the abort according to the RollOpts.PanicPolicy.
 	Fatal(a ...any)
 
-	// Call fmt.Sprintf, print the log, send a report encoded as eggtypes.Error, and exit.
+	// Call fmt.Sprintf, and abort the input as if the contract panicked.
+	// EggRoll handles the abort according to the RollOpts.PanicPolicy.
 	Fatalf(format string, a ...any)
 
 	// Return the list of addresses that have assets.
@@ -61,6 +66,25 @@ type EnvReader interface {
 
 	// Return the balance of the given address for the given token.
 	ERC20BalanceOf(token common.Address, address common.Address) *big.Int
+
+	// Return the list of ERC721 tokens with assets.
+	ERC721Tokens() []common.Address
+
+	// Return the owner of the given token id.
+	// Return the zero address if the token id is not in the wallet.
+	ERC721OwnerOf(token common.Address, tokenId *big.Int) common.Address
+
+	// Return the sorted list of token ids owned by the given address.
+	ERC721TokensOf(token common.Address, owner common.Address) []*big.Int
+
+	// Return the list of ERC1155 tokens with assets.
+	ERC1155Tokens() []common.Address
+
+	// Return the sorted list of token ids the given address has balance of.
+	ERC1155TokenIdsOf(token common.Address, address common.Address) []*big.Int
+
+	// Return the balance of the given address for the given token id.
+	ERC1155BalanceOf(token common.Address, address common.Address, tokenId *big.Int) *big.Int
 }
 
 // Read and write the rollups environment.
@@ -97,9 +121,43 @@ type Env interface {
 	// Return error if the source doesn't have enough funds.
 	ERC20Transfer(token common.Address, src common.Address, dst common.Address, value *big.Int) error
 
-	// Withdraw the asset from the wallet and generate the voucher to withdraw from the portal.
+	// Withdraw the asset from the wallet and generate the voucher to transfer it back
+	// from the DApp contract. Return the voucher index.
 	// Return error if the address doesn't have enough assets.
 	ERC20Withdraw(token common.Address, address common.Address, value *big.Int) (int, error)
+
+	// Transfer the token id from source to destination.
+	// Return error if the source doesn't own the token id.
+	ERC721Transfer(token common.Address, src common.Address, dst common.Address, tokenId *big.Int) error
+
+	// Withdraw the asset from the wallet and generate the voucher to transfer it back
+	// from the DApp contract. Return the voucher index.
+	// Return error if the address doesn't own the token id.
+	ERC721Withdraw(token common.Address, address common.Address, tokenId *big.Int) (int, error)
+
+	// Transfer the given amounts of token ids from source to destination.
+	// Return error if the source doesn't have enough funds for any of the token ids.
+	ERC1155Transfer(
+		token common.Address,
+		src common.Address,
+		dst common.Address,
+		tokenIds []*big.Int,
+		values []*big.Int,
+	) error
+
+	// Withdraw the asset from the wallet and generate the voucher to transfer it back
+	// from the DApp contract. Return the voucher index.
+	// Return error if the address doesn't have enough assets.
+	ERC1155WithdrawSingle(
+		token common.Address, address common.Address, tokenId *big.Int, value *big.Int,
+	) (int, error)
+
+	// Withdraw the assets from the wallet and generate the voucher to transfer them back
+	// from the DApp contract. Return the voucher index.
+	// Return error if the address doesn't have enough assets.
+	ERC1155WithdrawBatch(
+		token common.Address, address common.Address, tokenIds []*big.Int, values []*big.Int,
+	) (int, error)
 }
 
 // The MiddlewareContract is the on-chain part of a rollups DApp.
@@ -116,30 +174,107 @@ type MiddlewareContract interface {
 	Inspect(env EnvReader, input []byte) error
 }
 
+// Contracts that implement this interface have their state restored when
+// EggRoll rejects an advance input.
+// EggRoll calls Snapshot before each advance input and Restore when the
+// input is rejected, so the contract state matches the rollups state.
+type Snapshotter interface {
+
+	// Save the current contract state.
+	Snapshot()
+
+	// Restore the contract state saved by the last Snapshot.
+	Restore()
+}
+
+// Policy for when the contract panics while processing an input.
+type PanicPolicy int
+
+const (
+	// Report the panic, reject the input, and keep processing inputs.
+	PanicPolicyReject PanicPolicy = iota
+
+	// Report the panic and send an exception to the Rollups API.
+	// The Cartesi Machine halts after the exception.
+	PanicPolicyException
+)
+
+// Options for RollWithOpts.
+type RollOpts struct {
+
+	// Rollups API used to communicate with the Cartesi Machine.
+	// If nil, EggRoll uses the Rollups HTTP API.
+	RollupsAPI rollups.RollupsAPI
+
+	// What to do when the contract panics or calls env.Fatal.
+	PanicPolicy PanicPolicy
+
+	// Deployment of the Cartesi Rollups contracts, used to identify the inputs from the
+	// portals and the DApp address relay. If nil, EggRoll uses the localhost deployment.
+	Deployment *eggeth.Deployment
+
+	// If true, EggRoll answers the eggtypes.EtherBalanceOf and eggtypes.ERC20BalanceOf
+	// inspects with the wallet balance without calling the contract's Inspect.
+	// Otherwise, the contract receives every inspect.
+	WalletInspects bool
+}
+
 // Start the Cartesi rollups for the contract.
 // This function doesn't return and exits if there is an error.
 func Roll(contract MiddlewareContract) {
-	rollupsAPI := rollups.NewRollupsHTTP()
-	env := newEnv(rollupsAPI)
+	err := RollWithOpts(contract, RollOpts{})
+	log.Fatal(err)
+}
+
+// Start the Cartesi rollups for the contract with the given options.
+// This function only returns if it fails to communicate with the Rollups API,
+// after sending an exception, or when the in-memory Rollups API is closed.
+func RollWithOpts(contract MiddlewareContract, opts RollOpts) error {
+	rollupsAPI := opts.RollupsAPI
+	if rollupsAPI == nil {
+		rollupsAPI = rollups.NewRollupsHTTP()
+	}
+	deployment := eggeth.LocalhostDeployment()
+	if opts.Deployment != nil {
+		deployment = *opts.Deployment
+	}
+	env := newEnv(rollupsAPI, deployment)
 	status := rollups.FinishStatusAccept
 	for {
 		input, err := rollupsAPI.Finish(status)
+		if err == rollups.ErrClosed {
+			return nil
+		}
 		if err != nil {
-			env.Fatalf("failed to send finish: %v\n", err)
+			return fmt.Errorf("failed to send finish: %v", err)
 		}
 
 		switch input := input.(type) {
 		case *rollups.AdvanceInput:
 			err = handleAdvance(env, contract, input)
 		case *rollups.InspectInput:
-			err = handleInspect(env, contract, input)
+			err = handleInspect(env, contract, input, opts.WalletInspects)
 		default:
 			// impossible
 			panic("invalid input type")
 		}
 
+		_, isPanic := err.(*panicError)
+		if isPanic && opts.PanicPolicy == PanicPolicyException {
+			if reportErr := env.sendError(err.Error()); reportErr != nil {
+				return reportErr
+			}
+			err = rollupsAPI.SendException(eggtypes.EncodeError(err.Error()))
+			if err != nil {
+				return fmt.Errorf("failed to send exception: %v", err)
+			}
+			return fmt.Errorf("contract raised an exception")
+		}
+
 		if err != nil {
-			env.Errorf("rejecting: %v\n", err)
+			if reportErr := env.sendError(fmt.Sprintf("rejecting: %v\n", err)); reportErr != nil {
+				return reportErr
+			}
 			status = rollups.FinishStatusReject
 			continue
 		}
@@ -148,15 +283,38 @@ func Roll(contract MiddlewareContract) {
 	}
 }
 
+// Handle the advance input, restoring the state if the input is rejected.
 func handleAdvance(
 	env *env,
 	contract MiddlewareContract,
 	input *rollups.AdvanceInput,
+) error {
+	snapshotter, _ := contract.(Snapshotter)
+	env.snapshot()
+	if snapshotter != nil {
+		snapshotter.Snapshot()
+	}
+	err := recoverPanic(func() error {
+		return advance(env, contract, input)
+	})
+	if err != nil {
+		env.restore()
+		if snapshotter != nil {
+			snapshotter.Restore()
+		}
+	}
+	return err
+}
+
+func advance(
+	env *env,
+	contract MiddlewareContract,
+	input *rollups.AdvanceInput,
 ) error {
 	var deposit eggwallets.Deposit
 	var rawInput []byte
 
-	if input.Metadata.Sender == eggeth.AddressDAppAddressRelay {
+	if input.Metadata.Sender == env.deployment.DAppAddressRelay {
 		return handleDAppAddressRelay(env, input.Payload)
 	}
 
@@ -190,7 +348,61 @@ func handleInspect(
 	env *env,
 	contract MiddlewareContract,
 	input *rollups.InspectInput,
+	walletInspects bool,
 ) error {
 	env.setInputData(nil, nil)
-	return contract.Inspect(env, input.Payload)
+	return recoverPanic(func() error {
+		if walletInspects && handleWalletInspect(env, input.Payload) {
+			return nil
+		}
+		return contract.Inspect(env, input.Payload)
+	})
+}
+
+// Report the wallet balance if the inspect is one of the balance requests in eggtypes.
+// Return false if the inspect should go to the contract.
+func handleWalletInspect(env *env, payload []byte) bool {
+	value, err := eggtypes.Decode(payload)
+	if err != nil {
+		return false
+	}
+	switch value := value.(type) {
+	case eggtypes.EtherBalanceOf:
+		balance := env.EtherBalanceOf(value.Account)
+		env.Report(eggtypes.EncodeEtherBalance(value.Account, balance))
+	case eggtypes.ERC20BalanceOf:
+		balance := env.ERC20BalanceOf(value.Token, value.Account)
+		env.Report(eggtypes.EncodeERC20Balance(value.Token, value.Account, balance))
+	default:
+		return false
+	}
+	return true
+}
+
+// Error created when the contract panics while processing an input.
+type panicError struct {
+	value any
+	stack []byte
+}
+
+func (e *panicError) Error() string {
+	if fatal, ok := e.value.(fatalError); ok {
+		return fmt.Sprintf("fatal: %v\n\n%s", fatal.message, e.stack)
+	}
+	return fmt.Sprintf("panic: %v\n\n%s", e.value, e.stack)
+}
+
+// Value used by env.Fatal to abort the current input.
+type fatalError struct {
+	message string
+}
+
+// Call the function, converting a panic into a panicError.
+func recoverPanic(f func() error) (err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			err = &panicError{value, debug.Stack()}
+		}
+	}()
+	return f()
 }
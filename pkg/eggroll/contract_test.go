@@ -0,0 +1,239 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggroll
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gligneul/eggroll/internal/rollups"
+	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+)
+
+// Contract that transfers the deposit to the owner and counts the inputs.
+// It rejects the input if the payload is "reject" and panics if it is "panic".
+type transferContract struct {
+	owner common.Address
+	count int
+	saved int
+}
+
+func (c *transferContract) Advance(env Env, input []byte) error {
+	c.count++
+	deposit := env.Deposit()
+	if deposit != nil {
+		value := env.EtherBalanceOf(env.Sender())
+		if err := env.EtherTransfer(env.Sender(), c.owner, value); err != nil {
+			return err
+		}
+	}
+	if string(input) == "reject" {
+		return fmt.Errorf("rejected")
+	}
+	if string(input) == "panic" {
+		panic("transfer panic")
+	}
+	return nil
+}
+
+func (c *transferContract) Inspect(env EnvReader, input []byte) error {
+	return nil
+}
+
+func (c *transferContract) Snapshot() {
+	c.saved = c.count
+}
+
+func (c *transferContract) Restore() {
+	c.count = c.saved
+}
+
+// Start a fake rollups server that accepts every report.
+func setupRollups(t *testing.T) *rollups.RollupsHTTP {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		},
+	))
+	t.Cleanup(server.Close)
+	t.Setenv("ROLLUP_HTTP_SERVER_URL", server.URL)
+	return rollups.NewRollupsHTTP()
+}
+
+// Encode an input that came from the Ether portal.
+func makeEtherDeposit(sender common.Address, value int64, input string) *rollups.AdvanceInput {
+	payload := append(sender.Bytes(), common.BigToHash(big.NewInt(value)).Bytes()...)
+	payload = append(payload, []byte(input)...)
+	return &rollups.AdvanceInput{
+		Metadata: &rollups.Metadata{
+			Sender: eggeth.LocalhostDeployment().EtherPortal,
+		},
+		Payload: payload,
+	}
+}
+
+func TestAdvanceRestoresStateOnReject(t *testing.T) {
+	env := newEnv(setupRollups(t), eggeth.LocalhostDeployment())
+	owner := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	sender := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	contract := &transferContract{owner: owner}
+
+	// accepted deposit
+	err := handleAdvance(env, contract, makeEtherDeposit(sender, 100, ""))
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if env.EtherBalanceOf(owner).Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("expected 100 balance in owner")
+	}
+
+	// rejected deposit
+	err = handleAdvance(env, contract, makeEtherDeposit(sender, 50, "reject"))
+	if err == nil {
+		t.Fatalf("expected error; got nil")
+	}
+	if env.EtherBalanceOf(owner).Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("expected 100 balance in owner; got %v", env.EtherBalanceOf(owner))
+	}
+	if env.EtherBalanceOf(sender).Sign() != 0 {
+		t.Fatalf("expected 0 balance in sender; got %v", env.EtherBalanceOf(sender))
+	}
+	if contract.count != 1 {
+		t.Fatalf("expected count 1; got %v", contract.count)
+	}
+}
+
+func TestAdvanceRestoresStateOnMalformedDeposit(t *testing.T) {
+	env := newEnv(setupRollups(t), eggeth.LocalhostDeployment())
+	owner := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	sender := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	contract := &transferContract{owner: owner}
+
+	err := handleAdvance(env, contract, makeEtherDeposit(sender, 100, ""))
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	input := &rollups.AdvanceInput{
+		Metadata: &rollups.Metadata{
+			Sender: eggeth.LocalhostDeployment().ERC20Portal,
+		},
+		Payload: common.Hex2Bytes("fafafa"),
+	}
+	err = handleAdvance(env, contract, input)
+	if err == nil {
+		t.Fatalf("expected error; got nil")
+	}
+	if env.EtherBalanceOf(owner).Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("expected 100 balance in owner")
+	}
+	if contract.count != 1 {
+		t.Fatalf("expected count 1; got %v", contract.count)
+	}
+}
+
+func TestAdvanceRestoresStateOnPanic(t *testing.T) {
+	env := newEnv(setupRollups(t), eggeth.LocalhostDeployment())
+	owner := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	sender := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	contract := &transferContract{owner: owner}
+
+	err := handleAdvance(env, contract, makeEtherDeposit(sender, 100, "panic"))
+	if _, ok := err.(*panicError); !ok {
+		t.Fatalf("expected panic error; got %v", err)
+	}
+	if !strings.Contains(err.Error(), "panic: transfer panic") {
+		t.Fatalf("wrong error message: %v", err)
+	}
+	if env.EtherBalanceOf(owner).Sign() != 0 {
+		t.Fatalf("expected 0 balance in owner; got %v", env.EtherBalanceOf(owner))
+	}
+	if contract.count != 0 {
+		t.Fatalf("expected count 0; got %v", contract.count)
+	}
+}
+
+func TestRollPanicPolicyExceptionHTTP(t *testing.T) {
+	var finishCount int
+	var exception []byte
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/finish":
+				finishCount++
+				if finishCount > 1 {
+					t.Errorf("unexpected finish after exception")
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				fmt.Fprintf(w, `{"request_type": "advance_state", "data": {
+					"payload": "%v",
+					"metadata": {"msg_sender": "%v", "input_index": 0}
+				}}`, hexutil.Encode([]byte("panic")), common.Address{}.Hex())
+			case "/report":
+				w.WriteHeader(http.StatusOK)
+			case "/exception":
+				var request struct {
+					Payload string `json:"payload"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+					t.Errorf("failed to decode exception: %v", err)
+				}
+				exception = common.FromHex(request.Payload)
+				w.WriteHeader(http.StatusOK)
+			default:
+				t.Errorf("unexpected request: %v", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		},
+	))
+	defer server.Close()
+	t.Setenv("ROLLUP_HTTP_SERVER_URL", server.URL)
+
+	opts := RollOpts{
+		RollupsAPI:  rollups.NewRollupsHTTP(),
+		PanicPolicy: PanicPolicyException,
+	}
+	err := RollWithOpts(&transferContract{}, opts)
+	if err == nil || err.Error() != "contract raised an exception" {
+		t.Fatalf("wrong error: %v", err)
+	}
+	value, err := eggtypes.Decode(exception)
+	if err != nil {
+		t.Fatalf("failed to decode exception payload: %v", err)
+	}
+	exceptionError, ok := value.(eggtypes.Error)
+	if !ok || !strings.Contains(exceptionError.Message, "panic: transfer panic") {
+		t.Fatalf("wrong exception: %v", value)
+	}
+}
+
+func TestReportFailureAbortsInput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	))
+	defer server.Close()
+	t.Setenv("ROLLUP_HTTP_SERVER_URL", server.URL)
+	env := newEnv(rollups.NewRollupsHTTP(), eggeth.LocalhostDeployment())
+
+	input := &rollups.InspectInput{
+		Payload: eggtypes.EncodeEtherBalanceOf(common.Address{}),
+	}
+	err := handleInspect(env, &transferContract{}, input, true)
+	if _, ok := err.(*panicError); !ok {
+		t.Fatalf("expected panic error; got %v", err)
+	}
+	if !strings.Contains(err.Error(), "failed to send report") {
+		t.Fatalf("wrong error message: %v", err)
+	}
+}
@@ -19,12 +19,15 @@ import (
 
 // Implementation of the Env and EnvReader interfaces.
 type env struct {
-	logger      *log.Logger
-	rollups     *rollups.RollupsHTTP
-	etherWallet *eggwallets.EtherWallet
-	erc20Wallet *eggwallets.ERC20Wallet
-	dappAddress *common.Address
-	walletMap   map[common.Address]eggwallets.Wallet
+	logger        *log.Logger
+	rollups       rollups.RollupsAPI
+	etherWallet   *eggwallets.EtherWallet
+	erc20Wallet   *eggwallets.ERC20Wallet
+	erc721Wallet  *eggwallets.ERC721Wallet
+	erc1155Wallet *eggwallets.ERC1155Wallet
+	dappAddress   *common.Address
+	deployment    eggeth.Deployment
+	walletMap     map[common.Address]eggwallets.Wallet
 
 	// The fields below should be set for each input.
 	metadata *rollups.Metadata
@@ -35,19 +38,27 @@ type env struct {
 // Internal methods
 //
 
-func newEnv(rollups *rollups.RollupsHTTP) *env {
+func newEnv(rollups rollups.RollupsAPI, deployment eggeth.Deployment) *env {
 	etherWallet := eggwallets.NewEtherWallet()
 	erc20Wallet := eggwallets.NewERC20Wallet()
+	erc721Wallet := eggwallets.NewERC721Wallet()
+	erc1155Wallet := eggwallets.NewERC1155Wallet()
 	walletMap := map[common.Address]eggwallets.Wallet{
-		eggeth.AddressEtherPortal: etherWallet,
-		eggeth.AddressERC20Portal: erc20Wallet,
+		deployment.EtherPortal:         etherWallet,
+		deployment.ERC20Portal:         erc20Wallet,
+		deployment.ERC721Portal:        erc721Wallet,
+		deployment.ERC1155SinglePortal: erc1155Wallet.SinglePortalWallet(),
+		deployment.ERC1155BatchPortal:  erc1155Wallet.BatchPortalWallet(),
 	}
 	return &env{
-		logger:      log.New(os.Stdout, "", 0),
-		rollups:     rollups,
-		etherWallet: etherWallet,
-		erc20Wallet: erc20Wallet,
-		walletMap:   walletMap,
+		logger:        log.New(os.Stdout, "", 0),
+		rollups:       rollups,
+		etherWallet:   etherWallet,
+		erc20Wallet:   erc20Wallet,
+		erc721Wallet:  erc721Wallet,
+		erc1155Wallet: erc1155Wallet,
+		deployment:    deployment,
+		walletMap:     walletMap,
 	}
 }
 
@@ -60,6 +71,24 @@ func (e *env) setDAppAddress(address *common.Address) {
 	e.dappAddress = address
 }
 
+// Save the state of the wallets that implement Snapshotter before an advance input.
+func (e *env) snapshot() {
+	for _, wallet := range e.walletMap {
+		if snapshotter, ok := wallet.(Snapshotter); ok {
+			snapshotter.Snapshot()
+		}
+	}
+}
+
+// Restore the state of the wallets that implement Snapshotter after a rejected input.
+func (e *env) restore() {
+	for _, wallet := range e.walletMap {
+		if snapshotter, ok := wallet.(Snapshotter); ok {
+			snapshotter.Restore()
+		}
+	}
+}
+
 // Log the message and send a report as Log.
 func (e *env) log(message string) {
 	e.logger.Print(message)
@@ -72,10 +101,19 @@ func (e *env) err(message string) {
 	e.Report(eggtypes.EncodeError(message))
 }
 
-// Log the message, send a report, and exit.
+// Log the message and send a report as Error outside of an input handler.
+// Unlike err, return an error instead of aborting when the report fails.
+func (e *env) sendError(message string) error {
+	e.logger.Print(message)
+	if err := e.rollups.SendReport(eggtypes.EncodeError(message)); err != nil {
+		return fmt.Errorf("failed to send report: %v", err)
+	}
+	return nil
+}
+
+// Abort the current input; Roll logs the message and sends the report.
 func (e *env) fatal(message string) {
-	e.err(message)
-	os.Exit(1)
+	panic(fatalError{message})
 }
 
 //
@@ -88,7 +126,7 @@ func (e *env) DAppAddress() *common.Address {
 
 func (e *env) Report(payload []byte) {
 	if err := e.rollups.SendReport(payload); err != nil {
-		e.logger.Fatalf("failed to send report: %v\n", err)
+		e.Fatalf("failed to send report: %v", err)
 	}
 }
 
@@ -136,6 +174,30 @@ func (e *env) ERC20BalanceOf(token common.Address, address common.Address) *big.
 	return e.erc20Wallet.BalanceOf(token, address)
 }
 
+func (e *env) ERC721Tokens() []common.Address {
+	return e.erc721Wallet.Tokens()
+}
+
+func (e *env) ERC721OwnerOf(token common.Address, tokenId *big.Int) common.Address {
+	return e.erc721Wallet.OwnerOf(token, tokenId)
+}
+
+func (e *env) ERC721TokensOf(token common.Address, owner common.Address) []*big.Int {
+	return e.erc721Wallet.TokensOf(token, owner)
+}
+
+func (e *env) ERC1155Tokens() []common.Address {
+	return e.erc1155Wallet.Tokens()
+}
+
+func (e *env) ERC1155TokenIdsOf(token common.Address, address common.Address) []*big.Int {
+	return e.erc1155Wallet.TokenIdsOf(token, address)
+}
+
+func (e *env) ERC1155BalanceOf(token common.Address, address common.Address, tokenId *big.Int) *big.Int {
+	return e.erc1155Wallet.BalanceOf(token, address, tokenId)
+}
+
 //
 // Implementation of Env
 //
@@ -195,5 +257,56 @@ func (e *env) ERC20Withdraw(token common.Address, address common.Address, value
 	if err != nil {
 		return 0, err
 	}
-	return e.Voucher(eggeth.AddressERC20Portal, voucher), nil
+	return e.Voucher(token, voucher), nil
+}
+
+func (e *env) ERC721Transfer(token common.Address, src common.Address, dst common.Address, tokenId *big.Int) error {
+	return e.erc721Wallet.Transfer(token, src, dst, tokenId)
+}
+
+func (e *env) ERC721Withdraw(token common.Address, address common.Address, tokenId *big.Int) (int, error) {
+	if e.dappAddress == nil {
+		return 0, fmt.Errorf("need dapp address to withdraw")
+	}
+	voucher, err := e.erc721Wallet.Withdraw(*e.dappAddress, token, address, tokenId)
+	if err != nil {
+		return 0, err
+	}
+	return e.Voucher(token, voucher), nil
+}
+
+func (e *env) ERC1155Transfer(
+	token common.Address,
+	src common.Address,
+	dst common.Address,
+	tokenIds []*big.Int,
+	values []*big.Int,
+) error {
+	return e.erc1155Wallet.Transfer(token, src, dst, tokenIds, values)
+}
+
+func (e *env) ERC1155WithdrawSingle(
+	token common.Address, address common.Address, tokenId *big.Int, value *big.Int,
+) (int, error) {
+	if e.dappAddress == nil {
+		return 0, fmt.Errorf("need dapp address to withdraw")
+	}
+	voucher, err := e.erc1155Wallet.WithdrawSingle(*e.dappAddress, token, address, tokenId, value)
+	if err != nil {
+		return 0, err
+	}
+	return e.Voucher(token, voucher), nil
+}
+
+func (e *env) ERC1155WithdrawBatch(
+	token common.Address, address common.Address, tokenIds []*big.Int, values []*big.Int,
+) (int, error) {
+	if e.dappAddress == nil {
+		return 0, fmt.Errorf("need dapp address to withdraw")
+	}
+	voucher, err := e.erc1155Wallet.WithdrawBatch(*e.dappAddress, token, address, tokenIds, values)
+	if err != nil {
+		return 0, err
+	}
+	return e.Voucher(token, voucher), nil
 }
@@ -0,0 +1,320 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggroll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/eggroll/internal/sunodo"
+	"github.com/gligneul/eggroll/pkg/eggeth"
+	"gopkg.in/yaml.v3"
+)
+
+// Default path of the project configuration file.
+const ProjectFile = "eggroll.yaml"
+
+// Name of the profile used when the project doesn't set a default one.
+const DevProfile = "dev"
+
+// Signer settings of a profile.
+// Prefer setting the secrets with environment variables instead of the project file.
+type ProfileSigner struct {
+	Mnemonic           string `yaml:"mnemonic"`
+	MnemonicIndex      uint32 `yaml:"mnemonicIndex"`
+	MnemonicPath       string `yaml:"mnemonicPath"`
+	MnemonicPassphrase string `yaml:"mnemonicPassphrase"`
+	PrivateKey         string `yaml:"privateKey"`
+	Keystore           string `yaml:"keystore"`
+	KeystorePassphrase string `yaml:"keystorePassphrase"`
+	RemoteSigner       string `yaml:"remoteSigner"`
+	RemoteAccount      string `yaml:"remoteAccount"`
+}
+
+// Environment profile of the project, such as dev, testnet, or prod.
+// The fields missing in the dev profile have the values of MakeDevProfile; the other
+// profiles must set their endpoints, deployment, and signer.
+type Profile struct {
+
+	// Name of the profile in the project file.
+	Name string `yaml:"-"`
+
+	// Address of the DApp contract; if empty, the dev profile uses the DApp deployed by sunodo.
+	DAppAddress string `yaml:"dappAddress"`
+
+	// Endpoints of the rollups node and the Ethereum node.
+	GraphqlEndpoint  string `yaml:"graphqlEndpoint"`
+	InspectEndpoint  string `yaml:"inspectEndpoint"`
+	ProviderEndpoint string `yaml:"providerEndpoint"`
+
+	// Network name of a registered deployment or path to a deployment file.
+	Deployment string `yaml:"deployment"`
+
+	// Signer that sends the transactions.
+	Signer ProfileSigner `yaml:"signer"`
+
+	// Path to the schema of the DApp.
+	Schema string `yaml:"schema"`
+
+	// Target for sunodo build.
+	BuildTarget string `yaml:"buildTarget"`
+}
+
+// Default path of the DApp schema in every profile.
+const DefaultSchema = "schema.yaml"
+
+// Create the dev profile, which uses the nodes started by sunodo.
+func MakeDevProfile() Profile {
+	return Profile{
+		Name:             DevProfile,
+		GraphqlEndpoint:  "http://localhost:8080/graphql",
+		InspectEndpoint:  "http://localhost:8080/inspect",
+		ProviderEndpoint: "ws://localhost:8545",
+		Deployment:       "localhost",
+		Signer: ProfileSigner{
+			Mnemonic:     eggeth.FoundryMnemonic,
+			MnemonicPath: eggeth.DefaultDerivationPath,
+		},
+		Schema: DefaultSchema,
+	}
+}
+
+// Project configuration file with the environment profiles. For instance:
+//
+//	defaultProfile: dev
+//	profiles:
+//	  dev: {}
+//	  testnet:
+//	    dappAddress: "0x..."
+//	    graphqlEndpoint: https://...
+//	    inspectEndpoint: https://...
+//	    providerEndpoint: https://...
+//	    deployment: sepolia.yaml
+//	    signer:
+//	      keystore: keystore.json
+type Project struct {
+	DefaultProfile string             `yaml:"defaultProfile"`
+	Profiles       map[string]Profile `yaml:"profiles"`
+}
+
+// Decode the project, filling the fields missing in the dev profile with the dev values.
+// The profiles are decoded over their defaults, so the fields set to zero in the file,
+// such as mnemonicIndex: 0, are kept.
+func (p *Project) UnmarshalYAML(node *yaml.Node) error {
+	var project struct {
+		DefaultProfile string               `yaml:"defaultProfile"`
+		Profiles       map[string]yaml.Node `yaml:"profiles"`
+	}
+	if err := node.Decode(&project); err != nil {
+		return err
+	}
+	p.DefaultProfile = project.DefaultProfile
+	p.Profiles = make(map[string]Profile)
+	for name, node := range project.Profiles {
+		profile := Profile{Schema: DefaultSchema}
+		if name == DevProfile {
+			profile = MakeDevProfile()
+		}
+		if err := node.Decode(&profile); err != nil {
+			return fmt.Errorf("invalid profile %v: %v", name, err)
+		}
+		profile.Name = name
+		p.Profiles[name] = profile
+	}
+	return nil
+}
+
+// Parse the project configuration file.
+func ParseProject(data []byte) (*Project, error) {
+	var project Project
+	if err := yaml.Unmarshal(data, &project); err != nil {
+		return nil, fmt.Errorf("failed to decode project: %v", err)
+	}
+	return &project, nil
+}
+
+// Get the profile with the given name and apply the overrides from the environment
+// variables. If the project doesn't have the dev profile, use the dev values.
+// If the name is empty, use EGGROLL_PROFILE, the project default, or the dev profile.
+func (p *Project) Profile(name string) (Profile, error) {
+	if name == "" {
+		name = os.Getenv("EGGROLL_PROFILE")
+	}
+	if name == "" {
+		name = p.DefaultProfile
+	}
+	if name == "" {
+		name = DevProfile
+	}
+	profile, ok := p.Profiles[name]
+	if !ok {
+		if name != DevProfile {
+			return Profile{}, fmt.Errorf("profile not found: %v", name)
+		}
+		profile = MakeDevProfile()
+	}
+	if err := overrideProfileFromEnv(&profile); err != nil {
+		return Profile{}, err
+	}
+	return profile, nil
+}
+
+// Load the profile from the project file.
+// If the file doesn't exist, only the dev profile is available.
+func LoadProfile(path string, name string) (Profile, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		data = nil
+	} else if err != nil {
+		return Profile{}, fmt.Errorf("failed to read project: %v", err)
+	}
+	project, err := ParseProject(data)
+	if err != nil {
+		return Profile{}, err
+	}
+	return project.Profile(name)
+}
+
+// Override the profile fields with the EGGROLL_* environment variables.
+func overrideProfileFromEnv(profile *Profile) error {
+	for name, field := range map[string]*string{
+		"EGGROLL_DAPP_ADDRESS":        &profile.DAppAddress,
+		"EGGROLL_GRAPHQL_ENDPOINT":    &profile.GraphqlEndpoint,
+		"EGGROLL_INSPECT_ENDPOINT":    &profile.InspectEndpoint,
+		"EGGROLL_PROVIDER_ENDPOINT":   &profile.ProviderEndpoint,
+		"EGGROLL_DEPLOYMENT":          &profile.Deployment,
+		"EGGROLL_SCHEMA":              &profile.Schema,
+		"EGGROLL_BUILD_TARGET":        &profile.BuildTarget,
+		"EGGROLL_MNEMONIC":            &profile.Signer.Mnemonic,
+		"EGGROLL_MNEMONIC_PATH":       &profile.Signer.MnemonicPath,
+		"EGGROLL_MNEMONIC_PASSPHRASE": &profile.Signer.MnemonicPassphrase,
+		"EGGROLL_PRIVATE_KEY":         &profile.Signer.PrivateKey,
+		"EGGROLL_KEYSTORE":            &profile.Signer.Keystore,
+		"EGGROLL_KEYSTORE_PASSPHRASE": &profile.Signer.KeystorePassphrase,
+		"EGGROLL_REMOTE_SIGNER":       &profile.Signer.RemoteSigner,
+		"EGGROLL_REMOTE_ACCOUNT":      &profile.Signer.RemoteAccount,
+	} {
+		if value, ok := os.LookupEnv(name); ok {
+			*field = value
+		}
+	}
+	if value, ok := os.LookupEnv("EGGROLL_MNEMONIC_INDEX"); ok {
+		index, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid EGGROLL_MNEMONIC_INDEX: %v", value)
+		}
+		profile.Signer.MnemonicIndex = uint32(index)
+	}
+	return nil
+}
+
+// Get the deployment of the profile.
+// The deployment is either a file, if it has a JSON or YAML extension, or a registered
+// network name.
+func (p *Profile) LoadDeployment() (eggeth.Deployment, error) {
+	switch filepath.Ext(p.Deployment) {
+	case ".json", ".yaml", ".yml":
+		return eggeth.LoadDeploymentFile(p.Deployment)
+	default:
+		return eggeth.GetDeployment(p.Deployment)
+	}
+}
+
+// Get the DApp address of the profile.
+// If the dev profile doesn't have one, get the address of the DApp deployed by sunodo.
+func (p *Profile) LoadDAppAddress() (common.Address, error) {
+	if p.DAppAddress == "" {
+		if p.Name != DevProfile {
+			return common.Address{}, fmt.Errorf("profile %v: missing dappAddress", p.Name)
+		}
+		address, err := sunodo.GetDAppAddress()
+		if err != nil {
+			return common.Address{}, fmt.Errorf("failed to get DApp address: %v", err)
+		}
+		return address, nil
+	}
+	if !common.IsHexAddress(p.DAppAddress) {
+		return common.Address{}, fmt.Errorf("invalid DApp address: %v", p.DAppAddress)
+	}
+	return common.HexToAddress(p.DAppAddress), nil
+}
+
+// Create the client config described by the profile.
+func (p *Profile) ClientConfig() (ClientConfig, error) {
+	for _, field := range []struct{ name, value string }{
+		{"graphqlEndpoint", p.GraphqlEndpoint},
+		{"inspectEndpoint", p.InspectEndpoint},
+		{"providerEndpoint", p.ProviderEndpoint},
+		{"deployment", p.Deployment},
+	} {
+		if field.value == "" {
+			return ClientConfig{}, fmt.Errorf("profile %v: missing %v", p.Name, field.name)
+		}
+	}
+	dappAddress, err := p.LoadDAppAddress()
+	if err != nil {
+		return ClientConfig{}, err
+	}
+	deployment, err := p.LoadDeployment()
+	if err != nil {
+		return ClientConfig{}, err
+	}
+	config := ClientConfig{
+		DAppAddress:      dappAddress,
+		GraphqlEndpoint:  p.GraphqlEndpoint,
+		InspectEndpoint:  p.InspectEndpoint,
+		ProviderEndpoint: p.ProviderEndpoint,
+		Deployment:       &deployment,
+	}
+	return config, nil
+}
+
+// Create the signer config described by the profile.
+func (p *Profile) SignerConfig() (eggeth.SignerConfig, error) {
+	config := eggeth.MakeSignerConfig()
+	if p.Signer.Mnemonic == "" && p.Signer.PrivateKey == "" &&
+		p.Signer.Keystore == "" && p.Signer.RemoteSigner == "" {
+		return config, fmt.Errorf("profile %v: missing signer", p.Name)
+	}
+	config.Mnemonic = p.Signer.Mnemonic
+	config.MnemonicAccountIndex = p.Signer.MnemonicIndex
+	config.MnemonicOpts.DerivationPath = p.Signer.MnemonicPath
+	config.MnemonicOpts.Passphrase = p.Signer.MnemonicPassphrase
+	config.PrivateKey = p.Signer.PrivateKey
+	config.KeystorePath = p.Signer.Keystore
+	config.KeystorePassphrase = p.Signer.KeystorePassphrase
+	config.RemoteEndpoint = p.Signer.RemoteSigner
+	if p.Signer.RemoteAccount != "" {
+		if !common.IsHexAddress(p.Signer.RemoteAccount) {
+			return config, fmt.Errorf("invalid remote account: %v", p.Signer.RemoteAccount)
+		}
+		config.RemoteAccount = common.HexToAddress(p.Signer.RemoteAccount)
+	}
+	return config, nil
+}
+
+// Create a new client with the given profile of the eggroll.yaml file in the current
+// directory. If the name is empty, use the default profile.
+// Return the signer described by the profile.
+func NewClientFromProfile(ctx context.Context, name string) (*Client, eggeth.Signer, error) {
+	profile, err := LoadProfile(ProjectFile, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	config, err := profile.ClientConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	signerConfig, err := profile.SignerConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	return NewClientWithSigner(ctx, config, signerConfig)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggroll
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/eggroll/pkg/eggeth"
+)
+
+const testProject = `
+defaultProfile: testnet
+profiles:
+  dev:
+    schema: dev.yaml
+    signer:
+      mnemonicIndex: 0
+  testnet:
+    dappAddress: "0xfafafafafafafafafafafafafafafafafafafafa"
+    graphqlEndpoint: https://node.example.com/graphql
+    inspectEndpoint: https://node.example.com/inspect
+    providerEndpoint: https://rpc.example.com
+    deployment: localhost
+    buildTarget: testnet
+    signer:
+      mnemonic: test test test test test test test test test test test junk
+      mnemonicIndex: 2
+  partial:
+    graphqlEndpoint: https://node.example.com/graphql
+`
+
+func TestProfile(t *testing.T) {
+	project, err := ParseProject([]byte(testProject))
+	if err != nil {
+		t.Fatalf("failed to parse project: %v", err)
+	}
+
+	// default profile
+	profile, err := project.Profile("")
+	if err != nil {
+		t.Fatalf("failed to get profile: %v", err)
+	}
+	if profile.Name != "testnet" || profile.BuildTarget != "testnet" {
+		t.Fatalf("wrong profile: %+v", profile)
+	}
+	if profile.GraphqlEndpoint != "https://node.example.com/graphql" ||
+		profile.InspectEndpoint != "https://node.example.com/inspect" {
+		t.Fatalf("wrong endpoints: %+v", profile)
+	}
+	if profile.Signer.MnemonicIndex != 2 || profile.Signer.MnemonicPath != "" ||
+		profile.Schema != DefaultSchema {
+		t.Fatalf("wrong profile: %+v", profile)
+	}
+	config, err := profile.ClientConfig()
+	if err != nil {
+		t.Fatalf("failed to create client config: %v", err)
+	}
+	if config.DAppAddress != common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa") {
+		t.Fatalf("wrong dapp address: %v", config.DAppAddress)
+	}
+	if config.Deployment.InputBox != eggeth.LocalhostDeployment().InputBox {
+		t.Fatalf("wrong deployment: %v", config.Deployment)
+	}
+
+	// dev profile, with the missing fields from the dev values
+	profile, err = project.Profile("dev")
+	if err != nil {
+		t.Fatalf("failed to get profile: %v", err)
+	}
+	expected := MakeDevProfile()
+	expected.Schema = "dev.yaml"
+	if profile != expected {
+		t.Fatalf("wrong profile: %+v", profile)
+	}
+
+	// profile without the dev values
+	profile, err = project.Profile("partial")
+	if err != nil {
+		t.Fatalf("failed to get profile: %v", err)
+	}
+	if profile.InspectEndpoint != "" || profile.Signer.Mnemonic != "" {
+		t.Fatalf("wrong profile: %+v", profile)
+	}
+	_, err = profile.ClientConfig()
+	if err == nil || err.Error() != "profile partial: missing inspectEndpoint" {
+		t.Fatalf("wrong error: %v", err)
+	}
+	_, err = profile.SignerConfig()
+	if err == nil || err.Error() != "profile partial: missing signer" {
+		t.Fatalf("wrong error: %v", err)
+	}
+	profile.InspectEndpoint = "https://node.example.com/inspect"
+	profile.ProviderEndpoint = "https://rpc.example.com"
+	profile.Deployment = "localhost"
+	_, err = profile.ClientConfig()
+	if err == nil || err.Error() != "profile partial: missing dappAddress" {
+		t.Fatalf("wrong error: %v", err)
+	}
+
+	// unknown profile
+	_, err = project.Profile("prod")
+	if err == nil || err.Error() != "profile not found: prod" {
+		t.Fatalf("wrong error: %v", err)
+	}
+}
+
+func TestProfileEnv(t *testing.T) {
+	project, err := ParseProject([]byte(testProject))
+	if err != nil {
+		t.Fatalf("failed to parse project: %v", err)
+	}
+	t.Setenv("EGGROLL_PROFILE", "dev")
+	t.Setenv("EGGROLL_SCHEMA", "env.yaml")
+	t.Setenv("EGGROLL_PRIVATE_KEY", "0x01")
+	t.Setenv("EGGROLL_MNEMONIC_INDEX", "3")
+	profile, err := project.Profile("")
+	if err != nil {
+		t.Fatalf("failed to get profile: %v", err)
+	}
+	if profile.Name != "dev" || profile.Schema != "env.yaml" {
+		t.Fatalf("wrong profile: %+v", profile)
+	}
+	signerConfig, err := profile.SignerConfig()
+	if err != nil {
+		t.Fatalf("failed to create signer config: %v", err)
+	}
+	if signerConfig.PrivateKey != "0x01" || signerConfig.MnemonicAccountIndex != 3 {
+		t.Fatalf("wrong signer config: %+v", signerConfig)
+	}
+
+	// the environment fills the fields missing in the file
+	t.Setenv("EGGROLL_PROFILE", "partial")
+	t.Setenv("EGGROLL_MNEMONIC_INDEX", "0")
+	profile, err = project.Profile("")
+	if err != nil {
+		t.Fatalf("failed to get profile: %v", err)
+	}
+	if _, err := profile.SignerConfig(); err != nil {
+		t.Fatalf("failed to create signer config: %v", err)
+	}
+
+	t.Setenv("EGGROLL_MNEMONIC_INDEX", "x")
+	_, err = project.Profile("")
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestLoadProfileWithoutFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ProjectFile)
+	profile, err := LoadProfile(path, "")
+	if err != nil {
+		t.Fatalf("failed to load profile: %v", err)
+	}
+	if profile != MakeDevProfile() {
+		t.Fatalf("expected dev profile; got %+v", profile)
+	}
+	_, err = LoadProfile(path, "testnet")
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/gligneul/eggroll/internal/sunodo"
+	"github.com/gligneul/eggroll/pkg/eggroll"
 )
 
 // Integration test options.
@@ -20,12 +22,16 @@ type IntegrationTesterOpts struct {
 	// Context of the sunodo Docker.
 	DockerContext string
 
-	// Target for sunodo build.
+	// Target for sunodo build; if empty, use the build target of the eggroll.yaml profile.
 	BuildTarget string
 
 	// If set, print sunodo Stderr.
 	Verbose bool
 
+	// Duration of the epoch; if zero, use the sunodo default.
+	// Set it to a small value to get voucher and notice proofs in the test.
+	EpochDuration time.Duration
+
 	// If set, skip the integration test.
 	Skip bool
 }
@@ -36,6 +42,7 @@ func MakeIntegrationTesterOpts() IntegrationTesterOpts {
 		DockerContext: ".",
 		BuildTarget:   "",
 		Verbose:       false,
+		EpochDuration: 0,
 		Skip:          true,
 	}
 }
@@ -88,6 +95,15 @@ func NewIntegrationTester(
 		}
 	}
 
+	// Get the build target from the profile of the eggroll.yaml file if not set
+	if opts.BuildTarget == "" {
+		profile, err := eggroll.LoadProfile(eggroll.ProjectFile, "")
+		if err != nil {
+			t.Fatalf("failed to load profile: %v", err)
+		}
+		opts.BuildTarget = profile.BuildTarget
+	}
+
 	integrationMutex.Lock()
 
 	t.Log("executing sunodo build")
@@ -103,10 +119,11 @@ func NewIntegrationTester(
 		stderr = os.Stderr
 	}
 	sunodoOpts := sunodo.RunOpts{
-		NoBackend: false,
-		Stdout:    os.Stdout,
-		Stderr:    stderr,
-		Ready:     ready,
+		NoBackend:     false,
+		EpochDuration: int(opts.EpochDuration.Seconds()),
+		Stdout:        os.Stdout,
+		Stderr:        stderr,
+		Ready:         ready,
 	}
 
 	// Start sunodo
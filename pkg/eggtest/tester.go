@@ -0,0 +1,288 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggtest
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/gligneul/eggroll/pkg/eggroll"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/eggroll/internal/rollups"
+)
+
+// Metadata of an advance input sent by the tester.
+// The tester sets the input index automatically.
+type Metadata struct {
+	Sender         common.Address
+	BlockNumber    int64
+	BlockTimestamp time.Time
+}
+
+// Run the DApp contract in the same process as the test, without sunodo.
+// The tester sends inputs directly to the contract using an in-memory
+// implementation of the Rollups API.
+type Tester struct {
+	rollups    *rollups.RollupsMemory
+	deployment eggeth.Deployment
+	done       <-chan error
+	inputIndex int
+	closeOnce  sync.Once
+	closeErr   error
+}
+
+// Create a new tester for the given contract.
+// It is necessary to Close the tester at the end of the test.
+func NewTester(contract eggroll.MiddlewareContract) *Tester {
+	return NewTesterWithOpts(contract, eggroll.RollOpts{})
+}
+
+// Create a new tester for the given contract with the given Roll options.
+// The tester overrides the RollupsAPI option.
+// It is necessary to Close the tester at the end of the test.
+func NewTesterWithOpts(contract eggroll.MiddlewareContract, opts eggroll.RollOpts) *Tester {
+	rollupsAPI := rollups.NewRollupsMemory()
+	opts.RollupsAPI = rollupsAPI
+	done := make(chan error, 1)
+	go func() {
+		defer rollupsAPI.Stop()
+		done <- eggroll.RollWithOpts(contract, opts)
+	}()
+	deployment := eggeth.LocalhostDeployment()
+	if opts.Deployment != nil {
+		deployment = *opts.Deployment
+	}
+	tester := &Tester{
+		rollups:    rollupsAPI,
+		deployment: deployment,
+		done:       done,
+	}
+	return tester
+}
+
+// Close the tester, stopping the contract.
+// Return the error that stopped the contract loop, if any.
+func (t *Tester) Close() error {
+	t.closeOnce.Do(func() {
+		t.rollups.Close()
+		t.closeErr = <-t.done
+	})
+	return t.closeErr
+}
+
+// Send an advance input with the given sender.
+// If the contract raised an exception in a previous input, the result status
+// is CompletionStatusMachineHalted.
+// The tester uses the current time as the block timestamp and the input
+// index as the block number.
+func (t *Tester) Advance(sender common.Address, payload []byte) *eggtypes.AdvanceResult {
+	metadata := Metadata{
+		Sender:         sender,
+		BlockNumber:    int64(t.inputIndex),
+		BlockTimestamp: time.Now(),
+	}
+	return t.AdvanceWithMetadata(metadata, payload)
+}
+
+// Send an advance input with the given metadata.
+// As in the rollups node, the vouchers and notices of rejected inputs are discarded.
+func (t *Tester) AdvanceWithMetadata(metadata Metadata, payload []byte) *eggtypes.AdvanceResult {
+	index := t.inputIndex
+	t.inputIndex++
+	input := &rollups.AdvanceInput{
+		Metadata: &rollups.Metadata{
+			InputIndex:     index,
+			Sender:         metadata.Sender,
+			BlockNumber:    metadata.BlockNumber,
+			BlockTimestamp: metadata.BlockTimestamp.Unix(),
+		},
+		Payload: payload,
+	}
+	result := &eggtypes.AdvanceResult{
+		Index:          index,
+		Payload:        payload,
+		Sender:         metadata.Sender,
+		BlockNumber:    metadata.BlockNumber,
+		BlockTimestamp: time.Unix(metadata.BlockTimestamp.Unix(), 0),
+	}
+	memResult, err := t.rollups.Process(input)
+	if err != nil {
+		result.Status = eggtypes.CompletionStatusMachineHalted
+		return result
+	}
+	result.Status = convertStatus(memResult)
+	result.Reports = convertReports(index, memResult.Reports)
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		return result
+	}
+	for i, memVoucher := range memResult.Vouchers {
+		voucher := eggtypes.Voucher{
+			InputIndex:  index,
+			OutputIndex: i,
+			Destination: memVoucher.Destination,
+			Payload:     memVoucher.Payload,
+		}
+		result.Vouchers = append(result.Vouchers, voucher)
+	}
+	for i, payload := range memResult.Notices {
+		notice := eggtypes.Notice{
+			InputIndex:  index,
+			OutputIndex: i,
+			Payload:     payload,
+		}
+		result.Notices = append(result.Notices, notice)
+	}
+	return result
+}
+
+// Send an inspect input.
+func (t *Tester) Inspect(payload []byte) *eggtypes.InspectResult {
+	input := &rollups.InspectInput{
+		Payload: payload,
+	}
+	result := &eggtypes.InspectResult{
+		ProcessedInputCount: t.inputIndex,
+	}
+	memResult, err := t.rollups.Process(input)
+	if err != nil {
+		result.Status = eggtypes.CompletionStatusMachineHalted
+		return result
+	}
+	result.Status = convertStatus(memResult)
+	result.Reports = convertReports(0, memResult.Reports)
+	return result
+}
+
+// Send the DApp address as if it came from the DAppAddressRelay contract.
+func (t *Tester) RelayDAppAddress(dappAddress common.Address) *eggtypes.AdvanceResult {
+	return t.Advance(t.deployment.DAppAddressRelay, dappAddress[:])
+}
+
+// Send an input as if it came from the Ether portal.
+func (t *Tester) DepositEther(
+	sender common.Address, value *big.Int, payload []byte) *eggtypes.AdvanceResult {
+
+	var deposit []byte
+	deposit = append(deposit, sender[:]...)
+	deposit = append(deposit, common.BigToHash(value).Bytes()...)
+	deposit = append(deposit, payload...)
+	return t.Advance(t.deployment.EtherPortal, deposit)
+}
+
+// Send an input as if it came from the ERC20 portal.
+func (t *Tester) DepositERC20(
+	token common.Address, sender common.Address, amount *big.Int, payload []byte,
+) *eggtypes.AdvanceResult {
+
+	var deposit []byte
+	deposit = append(deposit, 1) // success
+	deposit = append(deposit, token[:]...)
+	deposit = append(deposit, sender[:]...)
+	deposit = append(deposit, common.BigToHash(amount).Bytes()...)
+	deposit = append(deposit, payload...)
+	return t.Advance(t.deployment.ERC20Portal, deposit)
+}
+
+// Send an input as if it came from the ERC721 portal.
+func (t *Tester) DepositERC721(
+	token common.Address, sender common.Address, tokenId *big.Int, payload []byte,
+) *eggtypes.AdvanceResult {
+
+	var deposit []byte
+	deposit = append(deposit, token[:]...)
+	deposit = append(deposit, sender[:]...)
+	deposit = append(deposit, common.BigToHash(tokenId).Bytes()...)
+	deposit = append(deposit, encodeLayerData(nil, payload)...)
+	return t.Advance(t.deployment.ERC721Portal, deposit)
+}
+
+// Send an input as if it came from the ERC1155 single portal.
+func (t *Tester) DepositERC1155Single(
+	token common.Address, sender common.Address, tokenId *big.Int, value *big.Int, payload []byte,
+) *eggtypes.AdvanceResult {
+
+	var deposit []byte
+	deposit = append(deposit, token[:]...)
+	deposit = append(deposit, sender[:]...)
+	deposit = append(deposit, common.BigToHash(tokenId).Bytes()...)
+	deposit = append(deposit, common.BigToHash(value).Bytes()...)
+	deposit = append(deposit, encodeLayerData(nil, payload)...)
+	return t.Advance(t.deployment.ERC1155SinglePortal, deposit)
+}
+
+// Send an input as if it came from the ERC1155 batch portal.
+func (t *Tester) DepositERC1155Batch(
+	token common.Address,
+	sender common.Address,
+	tokenIds []*big.Int,
+	values []*big.Int,
+	payload []byte,
+) *eggtypes.AdvanceResult {
+
+	uint256ArrayType, _ := abi.NewType("uint256[]", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	args := abi.Arguments{
+		{Type: uint256ArrayType},
+		{Type: uint256ArrayType},
+		{Type: bytesType},
+		{Type: bytesType},
+	}
+	data, err := args.Pack(tokenIds, values, []byte{}, payload)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode batch deposit: %v", err))
+	}
+
+	var deposit []byte
+	deposit = append(deposit, token[:]...)
+	deposit = append(deposit, sender[:]...)
+	deposit = append(deposit, data...)
+	return t.Advance(t.deployment.ERC1155BatchPortal, deposit)
+}
+
+func convertStatus(result *rollups.MemoryResult) eggtypes.CompletionStatus {
+	if result.Exception != nil {
+		return eggtypes.CompletionStatusException
+	}
+	if result.Status == rollups.FinishStatusAccept {
+		return eggtypes.CompletionStatusAccepted
+	}
+	return eggtypes.CompletionStatusRejected
+}
+
+func convertReports(inputIndex int, payloads [][]byte) []eggtypes.Report {
+	var reports []eggtypes.Report
+	for i, payload := range payloads {
+		report := eggtypes.Report{
+			InputIndex:  inputIndex,
+			OutputIndex: i,
+			Payload:     payload,
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// Encode the base layer and exec layer data as the NFT portals do.
+func encodeLayerData(baseLayerData []byte, execLayerData []byte) []byte {
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	args := abi.Arguments{{Type: bytesType}, {Type: bytesType}}
+	if baseLayerData == nil {
+		baseLayerData = []byte{}
+	}
+	if execLayerData == nil {
+		execLayerData = []byte{}
+	}
+	data, err := args.Pack(baseLayerData, execLayerData)
+	if err != nil {
+		// This should not happen
+		panic(fmt.Sprintf("failed to encode layer data: %v", err))
+	}
+	return data
+}
@@ -0,0 +1,346 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggtest
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gligneul/eggroll/pkg/eggeth"
+	"github.com/gligneul/eggroll/pkg/eggroll"
+	"github.com/gligneul/eggroll/pkg/eggtypes"
+	"github.com/gligneul/eggroll/pkg/eggwallets"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Contract that echoes the input as outputs.
+// It rejects inputs equal to "reject" and panics on "panic" and "fatal".
+// It sends the outputs and then rejects inputs equal to "reject-outputs".
+type echoContract struct{}
+
+func (c *echoContract) Advance(env eggroll.Env, input []byte) error {
+	if string(input) == "reject" {
+		return fmt.Errorf("rejected")
+	}
+	if string(input) == "reject-outputs" {
+		env.Notice(input)
+		env.Voucher(env.Sender(), input)
+		return fmt.Errorf("rejected")
+	}
+	if string(input) == "panic" {
+		panic("eggroll panic")
+	}
+	if string(input) == "fatal" {
+		env.Fatal("eggroll fatal")
+	}
+	metadata := env.Metadata()
+	env.Logf("%v %v %v", metadata.InputIndex, metadata.BlockNumber, metadata.BlockTimestamp)
+	env.Notice(input)
+	env.Voucher(env.Sender(), input)
+	return nil
+}
+
+func (c *echoContract) Inspect(env eggroll.EnvReader, input []byte) error {
+	env.Report(input)
+	return nil
+}
+
+func TestTesterAdvance(t *testing.T) {
+	tester := NewTester(&echoContract{})
+	defer tester.Close()
+
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	for i := 0; i < 3; i++ {
+		metadata := Metadata{
+			Sender:         sender,
+			BlockNumber:    100,
+			BlockTimestamp: time.Unix(1000, 0),
+		}
+		result := tester.AdvanceWithMetadata(metadata, []byte("eggroll"))
+		if result.Status != eggtypes.CompletionStatusAccepted {
+			t.Fatalf("wrong status: %v", result.Status)
+		}
+		if result.Index != i {
+			t.Fatalf("wrong index: %v", result.Index)
+		}
+		logs := result.Logs()
+		if len(logs) != 1 || logs[0].Message != fmt.Sprintf("%v 100 1000", i) {
+			t.Fatalf("wrong logs: %v", logs)
+		}
+		if len(result.Notices) != 1 || string(result.Notices[0].Payload) != "eggroll" {
+			t.Fatalf("wrong notices: %v", result.Notices)
+		}
+		if len(result.Vouchers) != 1 || result.Vouchers[0].Destination != sender {
+			t.Fatalf("wrong vouchers: %v", result.Vouchers)
+		}
+	}
+}
+
+func TestTesterReject(t *testing.T) {
+	tester := NewTester(&echoContract{})
+	defer tester.Close()
+
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	result := tester.Advance(sender, []byte("reject"))
+	if result.Status != eggtypes.CompletionStatusRejected {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	if len(result.Notices) != 0 || len(result.Vouchers) != 0 {
+		t.Fatalf("expected no outputs")
+	}
+
+	result = tester.Advance(sender, []byte("reject-outputs"))
+	if result.Status != eggtypes.CompletionStatusRejected {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	if len(result.Notices) != 0 || len(result.Vouchers) != 0 {
+		t.Fatalf("expected no outputs; got %v %v", result.Notices, result.Vouchers)
+	}
+}
+
+func TestTesterClose(t *testing.T) {
+	tester := NewTester(&echoContract{})
+	if err := tester.Close(); err != nil {
+		t.Fatalf("failed to close: %v", err)
+	}
+	if err := tester.Close(); err != nil {
+		t.Fatalf("failed to close twice: %v", err)
+	}
+}
+
+func TestTesterInspect(t *testing.T) {
+	tester := NewTester(&echoContract{})
+	defer tester.Close()
+
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	tester.DepositEther(sender, big.NewInt(100), nil)
+	result := tester.Inspect([]byte("eggroll"))
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	if result.ProcessedInputCount != 1 {
+		t.Fatalf("wrong processed input count: %v", result.ProcessedInputCount)
+	}
+	if len(result.Reports) != 1 || string(result.Reports[0].Payload) != "eggroll" {
+		t.Fatalf("wrong reports: %v", result.Reports)
+	}
+}
+
+func TestTesterWalletInspect(t *testing.T) {
+	opts := eggroll.RollOpts{
+		WalletInspects: true,
+	}
+	tester := NewTesterWithOpts(&echoContract{}, opts)
+	defer tester.Close()
+
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	tester.DepositEther(sender, big.NewInt(100), nil)
+	tester.DepositERC20(token, sender, big.NewInt(50), nil)
+
+	result := tester.Inspect(eggtypes.EncodeEtherBalanceOf(sender))
+	etherBalance, found := eggtypes.FindReport[eggtypes.EtherBalance](
+		result.Reports, eggtypes.EtherBalanceID)
+	if !found || len(result.Reports) != 1 {
+		t.Fatalf("wrong reports: %v", result.Reports)
+	}
+	if etherBalance.Account != sender || etherBalance.Balance.Int64() != 100 {
+		t.Fatalf("wrong ether balance: %v", etherBalance)
+	}
+
+	result = tester.Inspect(eggtypes.EncodeERC20BalanceOf(token, sender))
+	erc20Balance, found := eggtypes.FindReport[eggtypes.ERC20Balance](
+		result.Reports, eggtypes.ERC20BalanceID)
+	if !found || len(result.Reports) != 1 {
+		t.Fatalf("wrong reports: %v", result.Reports)
+	}
+	if erc20Balance.Token != token || erc20Balance.Balance.Int64() != 50 {
+		t.Fatalf("wrong erc20 balance: %v", erc20Balance)
+	}
+}
+
+func TestTesterWalletInspectDisabled(t *testing.T) {
+	tester := NewTester(&echoContract{})
+	defer tester.Close()
+
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	tester.DepositEther(sender, big.NewInt(100), nil)
+
+	payload := eggtypes.EncodeEtherBalanceOf(sender)
+	result := tester.Inspect(payload)
+	if len(result.Reports) != 1 || !bytes.Equal(result.Reports[0].Payload, payload) {
+		t.Fatalf("expected the contract to get the inspect: %v", result.Reports)
+	}
+}
+
+func TestTesterPanicRejects(t *testing.T) {
+	tester := NewTester(&echoContract{})
+	defer tester.Close()
+
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	for _, input := range []string{"panic", "fatal"} {
+		result := tester.Advance(sender, []byte(input))
+		if result.Status != eggtypes.CompletionStatusRejected {
+			t.Fatalf("wrong status: %v", result.Status)
+		}
+		errors := eggtypes.FilterReports[eggtypes.Error](result.Reports, eggtypes.ErrorID)
+		if len(errors) != 1 || !strings.Contains(errors[0].Message, "eggroll "+input) {
+			t.Fatalf("wrong errors: %v", errors)
+		}
+	}
+	result := tester.Advance(sender, []byte("eggroll"))
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+}
+
+func TestTesterPanicException(t *testing.T) {
+	opts := eggroll.RollOpts{
+		PanicPolicy: eggroll.PanicPolicyException,
+	}
+	tester := NewTesterWithOpts(&echoContract{}, opts)
+	defer tester.Close()
+
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	result := tester.Advance(sender, []byte("panic"))
+	if result.Status != eggtypes.CompletionStatusException {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	result = tester.Advance(sender, []byte("eggroll"))
+	if result.Status != eggtypes.CompletionStatusMachineHalted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	if err := tester.Close(); err == nil {
+		t.Fatalf("expected exception error")
+	}
+}
+
+// Contract that withdraws every ERC721 and ERC1155 token owned by the sender.
+type nftContract struct{}
+
+func (c *nftContract) Advance(env eggroll.Env, input []byte) error {
+	if env.Deposit() != nil {
+		return nil
+	}
+	for _, token := range env.ERC721Tokens() {
+		for _, tokenId := range env.ERC721TokensOf(token, env.Sender()) {
+			if _, err := env.ERC721Withdraw(token, env.Sender(), tokenId); err != nil {
+				return err
+			}
+		}
+	}
+	for _, token := range env.ERC1155Tokens() {
+		tokenIds := env.ERC1155TokenIdsOf(token, env.Sender())
+		var values []*big.Int
+		for _, tokenId := range tokenIds {
+			values = append(values, env.ERC1155BalanceOf(token, env.Sender(), tokenId))
+		}
+		if _, err := env.ERC1155WithdrawBatch(token, env.Sender(), tokenIds, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *nftContract) Inspect(env eggroll.EnvReader, input []byte) error {
+	return nil
+}
+
+func TestTesterERC721(t *testing.T) {
+	tester := NewTester(&nftContract{})
+	defer tester.Close()
+
+	dapp := common.HexToAddress("0xdeaddeaddeaddeaddeaddeaddeaddeaddeaddead")
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	tester.RelayDAppAddress(dapp)
+	result := tester.DepositERC721(token, sender, big.NewInt(42), nil)
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	result = tester.Advance(sender, nil)
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	if len(result.Vouchers) != 1 || result.Vouchers[0].Destination != token {
+		t.Fatalf("wrong vouchers: %v", result.Vouchers)
+	}
+	expectedPayload := eggwallets.EncodeERC721Withdraw(dapp, sender, big.NewInt(42))
+	if !bytes.Equal(result.Vouchers[0].Payload, expectedPayload) {
+		t.Fatalf("wrong voucher payload: %x", result.Vouchers[0].Payload)
+	}
+}
+
+func TestTesterERC1155(t *testing.T) {
+	tester := NewTester(&nftContract{})
+	defer tester.Close()
+
+	dapp := common.HexToAddress("0xdeaddeaddeaddeaddeaddeaddeaddeaddeaddead")
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	tester.RelayDAppAddress(dapp)
+	result := tester.DepositERC1155Single(token, sender, big.NewInt(1), big.NewInt(10), nil)
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	result = tester.DepositERC1155Batch(token, sender,
+		[]*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(5), big.NewInt(20)}, nil)
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	result = tester.Advance(sender, nil)
+	if result.Status != eggtypes.CompletionStatusAccepted {
+		t.Fatalf("wrong status: %v", result.Status)
+	}
+	if len(result.Vouchers) != 1 || result.Vouchers[0].Destination != token {
+		t.Fatalf("wrong vouchers: %v", result.Vouchers)
+	}
+	expectedPayload := eggwallets.EncodeERC1155BatchWithdraw(dapp, sender,
+		[]*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(15), big.NewInt(20)})
+	if !bytes.Equal(result.Vouchers[0].Payload, expectedPayload) {
+		t.Fatalf("wrong voucher payload: %x", result.Vouchers[0].Payload)
+	}
+}
+
+// Contract that sends a notice with the deposit of each input.
+type depositContract struct{}
+
+func (c *depositContract) Advance(env eggroll.Env, input []byte) error {
+	env.Notice([]byte(fmt.Sprint(env.Deposit())))
+	return nil
+}
+
+func (c *depositContract) Inspect(env eggroll.EnvReader, input []byte) error {
+	return nil
+}
+
+func TestTesterDeployment(t *testing.T) {
+	deployment := eggeth.LocalhostDeployment()
+	deployment.Network = "testnet"
+	deployment.EtherPortal = common.HexToAddress("0x0000000000000000000000000000000000000001")
+	opts := eggroll.RollOpts{
+		Deployment: &deployment,
+	}
+	tester := NewTesterWithOpts(&depositContract{}, opts)
+	defer tester.Close()
+
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	result := tester.DepositEther(sender, big.NewInt(100), nil)
+	expectedNotice := "0xfafafafafafafafafafafafafafafafafafafafa deposited 0.000000000000000100 Ether"
+	if len(result.Notices) != 1 || string(result.Notices[0].Payload) != expectedNotice {
+		t.Fatalf("wrong notices: %v", result.Notices)
+	}
+
+	// the localhost portal is a regular sender in this deployment
+	payload := append(sender.Bytes(), common.BigToHash(big.NewInt(100)).Bytes()...)
+	result = tester.Advance(eggeth.LocalhostDeployment().EtherPortal, payload)
+	if len(result.Notices) != 1 || string(result.Notices[0].Payload) != "<nil>" {
+		t.Fatalf("wrong notices: %v", result.Notices)
+	}
+}
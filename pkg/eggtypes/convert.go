@@ -0,0 +1,185 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggtypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Convert a value decoded from JSON into the Go type used by the ABI to pack the given type.
+// Values that already have the ABI Go type are returned as is.
+func convertValue(t abi.Type, value any) (any, error) {
+	if value == nil {
+		return nil, fmt.Errorf("missing value")
+	}
+	goType := t.GetType()
+	if reflect.TypeOf(value) == goType {
+		return value, nil
+	}
+	converted, err := convertReflectValue(t, value)
+	if err != nil {
+		return nil, err
+	}
+	return converted.Interface(), nil
+}
+
+func convertReflectValue(t abi.Type, value any) (reflect.Value, error) {
+	goType := t.GetType()
+	if v := reflect.ValueOf(value); v.IsValid() && v.Type() == goType {
+		return v, nil
+	}
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		n, err := convertInt(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if goType == reflect.TypeOf(&big.Int{}) {
+			return reflect.ValueOf(n), nil
+		}
+		v := reflect.New(goType).Elem()
+		if t.T == abi.IntTy {
+			if !n.IsInt64() || v.OverflowInt(n.Int64()) {
+				return reflect.Value{}, fmt.Errorf("%v overflows %v", n, t)
+			}
+			v.SetInt(n.Int64())
+		} else {
+			if !n.IsUint64() || v.OverflowUint(n.Uint64()) {
+				return reflect.Value{}, fmt.Errorf("%v overflows %v", n, t)
+			}
+			v.SetUint(n.Uint64())
+		}
+		return v, nil
+	case abi.BoolTy:
+		b, ok := value.(bool)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected bool; got %T", value)
+		}
+		return reflect.ValueOf(b), nil
+	case abi.StringTy:
+		s, ok := value.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected string; got %T", value)
+		}
+		return reflect.ValueOf(s), nil
+	case abi.AddressTy:
+		s, ok := value.(string)
+		if !ok || !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("expected hex address; got %v", value)
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+	case abi.BytesTy:
+		data, err := convertBytes(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(data), nil
+	case abi.FixedBytesTy:
+		data, err := convertBytes(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(data) != t.Size {
+			return reflect.Value{}, fmt.Errorf("expected %v bytes; got %v", t.Size, len(data))
+		}
+		v := reflect.New(goType).Elem()
+		reflect.Copy(v, reflect.ValueOf(data))
+		return v, nil
+	case abi.SliceTy, abi.ArrayTy:
+		elems, ok := value.([]any)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected array; got %T", value)
+		}
+		var v reflect.Value
+		if t.T == abi.SliceTy {
+			v = reflect.MakeSlice(goType, len(elems), len(elems))
+		} else if len(elems) != t.Size {
+			return reflect.Value{}, fmt.Errorf("expected %v elements; got %v", t.Size, len(elems))
+		} else {
+			v = reflect.New(goType).Elem()
+		}
+		for i, elem := range elems {
+			elemValue, err := convertReflectValue(*t.Elem, elem)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("element %v: %v", i, err)
+			}
+			v.Index(i).Set(elemValue)
+		}
+		return v, nil
+	case abi.TupleTy:
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected object; got %T", value)
+		}
+		v := reflect.New(goType).Elem()
+		for i, name := range t.TupleRawNames {
+			field, ok := fields[name]
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("missing field %v", name)
+			}
+			fieldValue, err := convertReflectValue(*t.TupleElems[i], field)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("field %v: %v", name, err)
+			}
+			v.FieldByName(abi.ToCamelCase(name)).Set(fieldValue)
+		}
+		return v, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported type %v", t)
+	}
+}
+
+// Convert a JSON number or a decimal or hex string into a big int.
+func convertInt(value any) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case json.Number:
+		return convertInt(string(v))
+	case string:
+		n, ok := new(big.Int).SetString(v, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer: %v", v)
+		}
+		return n, nil
+	case float64:
+		if v != math.Trunc(v) || math.Abs(v) > 1<<53 {
+			return nil, fmt.Errorf("invalid integer: %v; use a string for big values", v)
+		}
+		return big.NewInt(int64(v)), nil
+	default:
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return big.NewInt(rv.Int()), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return new(big.Int).SetUint64(rv.Uint()), nil
+		}
+		return nil, fmt.Errorf("expected integer; got %T", value)
+	}
+}
+
+// Convert a hex string into bytes.
+func convertBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		data, err := hexutil.Decode(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex: %v", err)
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("expected hex string; got %T", value)
+	}
+}
@@ -99,6 +99,9 @@ func Decode(data []byte) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	if schema.Decoder == nil {
+		return nil, fmt.Errorf("schema %v doesn't have a decoder", schema.Kind)
+	}
 	values, err := schema.Arguments.Unpack(data[4:])
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode: %v", err)
@@ -121,6 +124,9 @@ func DecodeIntoMap(m map[string]any, data []byte) (string, error) {
 }
 
 // Encode the JSON message into an ABI payload.
+// Besides the Go types of the ABI, the map may have the values decoded from JSON:
+// integers as numbers or strings, addresses and bytes as hex strings, arrays as
+// slices, and structs as maps.
 func EncodeFromMap(kind string, m map[string]any) ([]byte, error) {
 	schema, ok := schemas.byKind[kind]
 	if !ok {
@@ -128,7 +134,11 @@ func EncodeFromMap(kind string, m map[string]any) ([]byte, error) {
 	}
 	values := make([]any, len(schema.Arguments))
 	for i, arg := range schema.Arguments {
-		values[i] = m[arg.Name]
+		value, err := convertValue(arg.Type, m[arg.Name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v: %v", arg.Name, err)
+		}
+		values[i] = value
 	}
 	data, err := schema.Arguments.PackValues(values)
 	if err != nil {
@@ -190,7 +200,7 @@ func EncodeError(Message string) []byte {
 	if err != nil {
 		panic(fmt.Sprintf("failed to encode error: %v", err))
 	}
-	return append(LogID[:], data...)
+	return append(ErrorID[:], data...)
 }
 
 // Encode the error into binary data.
@@ -237,6 +247,78 @@ const _JSON_ABI = `[
     "outputs": [],
     "stateMutability": "",
     "type": "function"
+  },
+  {
+    "inputs": [
+      {
+	"internalType": "address",
+	"name": "account",
+	"type": "address"
+      }
+    ],
+    "name": "etherBalanceOf",
+    "outputs": [],
+    "stateMutability": "",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+	"internalType": "address",
+	"name": "account",
+	"type": "address"
+      },
+      {
+	"internalType": "uint256",
+	"name": "balance",
+	"type": "uint256"
+      }
+    ],
+    "name": "etherBalance",
+    "outputs": [],
+    "stateMutability": "",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+	"internalType": "address",
+	"name": "token",
+	"type": "address"
+      },
+      {
+	"internalType": "address",
+	"name": "account",
+	"type": "address"
+      }
+    ],
+    "name": "erc20BalanceOf",
+    "outputs": [],
+    "stateMutability": "",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+	"internalType": "address",
+	"name": "token",
+	"type": "address"
+      },
+      {
+	"internalType": "address",
+	"name": "account",
+	"type": "address"
+      },
+      {
+	"internalType": "uint256",
+	"name": "balance",
+	"type": "uint256"
+      }
+    ],
+    "name": "erc20Balance",
+    "outputs": [],
+    "stateMutability": "",
+    "type": "function"
   }
 ]`
 
@@ -267,4 +349,36 @@ func init() {
 		Arguments: _abi.Methods["error"].Inputs,
 		Decoder:   _error_Decode,
 	})
+
+	EtherBalanceOfID = ID(_abi.Methods["etherBalanceOf"].ID)
+	MustAddSchema(MessageSchema{
+		ID:        EtherBalanceOfID,
+		Kind:      "etherBalanceOf",
+		Arguments: _abi.Methods["etherBalanceOf"].Inputs,
+		Decoder:   _etherBalanceOf_Decode,
+	})
+
+	EtherBalanceID = ID(_abi.Methods["etherBalance"].ID)
+	MustAddSchema(MessageSchema{
+		ID:        EtherBalanceID,
+		Kind:      "etherBalance",
+		Arguments: _abi.Methods["etherBalance"].Inputs,
+		Decoder:   _etherBalance_Decode,
+	})
+
+	ERC20BalanceOfID = ID(_abi.Methods["erc20BalanceOf"].ID)
+	MustAddSchema(MessageSchema{
+		ID:        ERC20BalanceOfID,
+		Kind:      "erc20BalanceOf",
+		Arguments: _abi.Methods["erc20BalanceOf"].Inputs,
+		Decoder:   _erc20BalanceOf_Decode,
+	})
+
+	ERC20BalanceID = ID(_abi.Methods["erc20Balance"].ID)
+	MustAddSchema(MessageSchema{
+		ID:        ERC20BalanceID,
+		Kind:      "erc20Balance",
+		Arguments: _abi.Methods["erc20Balance"].Inputs,
+		Decoder:   _erc20Balance_Decode,
+	})
 }
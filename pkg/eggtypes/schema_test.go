@@ -5,8 +5,13 @@ package eggtypes
 
 import (
 	"bytes"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -41,3 +46,134 @@ func TestLogABI(t *testing.T) {
 		t.Fatalf("wrong payload")
 	}
 }
+
+func TestErrorABI(t *testing.T) {
+	if bytes.Compare(ErrorID[:], common.Hex2Bytes("24c93343")) != 0 {
+		t.Fatalf("wrong error id: %x", ErrorID[:])
+	}
+
+	error_ := &Error{
+		Message: "hello",
+	}
+	expectedData := common.Hex2Bytes("24c93343" +
+		// offset
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		// num bytes
+		"0000000000000000000000000000000000000000000000000000000000000005" +
+		// value
+		"68656c6c6f000000000000000000000000000000000000000000000000000000")
+
+	// Test pack
+	packData := error_.Encode()
+	if !bytes.Equal(packData, expectedData) {
+		t.Fatalf("wrong pack return; got %x", packData)
+	}
+
+	// Test unpack; errors used to be encoded with the log id and decoded as logs
+	value, err := Decode(EncodeError("hello"))
+	if err != nil {
+		t.Fatalf("failed to decode error: %v", err)
+	}
+	decoded, ok := value.(Error)
+	if !ok {
+		t.Fatalf("expected error; got %T", value)
+	}
+	if decoded.Message != "hello" {
+		t.Fatalf("wrong payload")
+	}
+}
+
+// Register the test schema only once, so the test can run more than once.
+var addEncodeTestSchema sync.Once
+
+func TestEncodeFromJSONMap(t *testing.T) {
+	jsonAbi := `[{"type":"function","name":"encodeTest","inputs":[
+		{"name":"uintValue","type":"uint256"},
+		{"name":"smallValue","type":"int8"},
+		{"name":"address","type":"address"},
+		{"name":"bytes","type":"bytes"},
+		{"name":"hash","type":"bytes32"},
+		{"name":"values","type":"uint64[]"},
+		{"name":"items","type":"tuple[]","components":[
+			{"name":"flag","type":"bool"},
+			{"name":"name","type":"string"}
+		]}
+	]}]`
+	a, err := abi.JSON(strings.NewReader(jsonAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	method := a.Methods["encodeTest"]
+	addEncodeTestSchema.Do(func() {
+		MustAddSchema(MessageSchema{
+			ID:        ID(method.ID),
+			Kind:      method.Name,
+			Arguments: method.Inputs,
+		})
+	})
+
+	jsonArgs := `{
+		"uintValue": "115792089237316195423570985008687907853269984665640564039457584007913129639935",
+		"smallValue": -5,
+		"address": "0xfafafafafafafafafafafafafafafafafafafafa",
+		"bytes": "0xdeadbeef",
+		"hash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+		"values": [1, "0x2"],
+		"items": [{"flag": true, "name": "eggroll"}]
+	}`
+	decoder := json.NewDecoder(strings.NewReader(jsonArgs))
+	decoder.UseNumber()
+	args := make(map[string]any)
+	if err := decoder.Decode(&args); err != nil {
+		t.Fatal(err)
+	}
+	payload, err := EncodeFromMap("encodeTest", args)
+	if err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+
+	decoded := make(map[string]any)
+	kind, err := DecodeIntoMap(decoded, payload)
+	if err != nil {
+		t.Fatalf("failed to decode: %v", err)
+	}
+	if kind != "encodeTest" {
+		t.Fatalf("wrong kind: %v", kind)
+	}
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	if decoded["uintValue"].(*big.Int).Cmp(maxUint256) != 0 {
+		t.Fatalf("wrong uint value: %v", decoded["uintValue"])
+	}
+	if decoded["smallValue"].(int8) != -5 {
+		t.Fatalf("wrong small value: %v", decoded["smallValue"])
+	}
+	if decoded["address"].(common.Address) != common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa") {
+		t.Fatalf("wrong address: %v", decoded["address"])
+	}
+	if !bytes.Equal(decoded["bytes"].([]byte), common.Hex2Bytes("deadbeef")) {
+		t.Fatalf("wrong bytes: %v", decoded["bytes"])
+	}
+	if decoded["hash"].([32]byte) != common.HexToHash("0x1") {
+		t.Fatalf("wrong hash: %v", decoded["hash"])
+	}
+	values := decoded["values"].([]uint64)
+	if len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Fatalf("wrong values: %v", values)
+	}
+	items, err := json.Marshal(decoded["items"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(items) != `[{"flag":true,"name":"eggroll"}]` {
+		t.Fatalf("wrong items: %s", items)
+	}
+
+	args["smallValue"] = json.Number("128")
+	if _, err := EncodeFromMap("encodeTest", args); err == nil {
+		t.Fatalf("expected overflow error")
+	}
+	delete(args, "address")
+	if _, err := EncodeFromMap("encodeTest", args); err == nil {
+		t.Fatalf("expected missing value error")
+	}
+}
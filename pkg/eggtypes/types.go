@@ -25,6 +25,24 @@ const (
 	CompletionStatusPayloadLengthLimitExceeded
 )
 
+var completionStatusNames = []string{
+	"Unprocessed",
+	"Accepted",
+	"Rejected",
+	"Exception",
+	"MachineHalted",
+	"CycleLimitExceeded",
+	"TimeLimitExceeded",
+	"PayloadLengthLimitExceeded",
+}
+
+func (s CompletionStatus) String() string {
+	if s < 0 || int(s) >= len(completionStatusNames) {
+		return fmt.Sprintf("CompletionStatus(%d)", int(s))
+	}
+	return completionStatusNames[s]
+}
+
 // Result of an request.
 type Result struct {
 
@@ -79,12 +97,34 @@ type Voucher struct {
 	OutputIndex int
 	Destination common.Address
 	Payload     []byte
+
+	// Proof to execute the voucher in the DApp contract.
+	// The proof is nil until the epoch of the voucher is finalized.
+	Proof *Proof
+}
+
+// Proof that an output is part of the finalized DApp state.
+// The proof is necessary to execute vouchers and validate notices on the base layer.
+type Proof struct {
+	InputIndexWithinEpoch            uint64
+	OutputIndexWithinInput           uint64
+	OutputHashesRootHash             common.Hash
+	VouchersEpochRootHash            common.Hash
+	NoticesEpochRootHash             common.Hash
+	MachineStateHash                 common.Hash
+	OutputHashInOutputHashesSiblings []common.Hash
+	OutputHashesInEpochSiblings      []common.Hash
+	Context                          []byte
 }
 
 type Notice struct {
 	InputIndex  int
 	OutputIndex int
 	Payload     []byte
+
+	// Proof to validate the notice in the DApp contract.
+	// The proof is nil until the epoch of the notice is finalized.
+	Proof *Proof
 }
 
 type Report struct {
@@ -0,0 +1,185 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggtypes
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Inspect message that requests the Ether balance of an account in the DApp wallet.
+// EggRoll answers it with an EtherBalance report, without calling the contract.
+type EtherBalanceOf struct {
+	Account common.Address
+}
+
+// ID for the Ether balance request.
+var EtherBalanceOfID ID
+
+// Encode the Ether balance request into binary data.
+func EncodeEtherBalanceOf(Account common.Address) []byte {
+	values := make([]any, 1)
+	values[0] = Account
+	data, err := _abi.Methods["etherBalanceOf"].Inputs.PackValues(values)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode etherBalanceOf: %v", err))
+	}
+	return append(EtherBalanceOfID[:], data...)
+}
+
+// Encode the Ether balance request into binary data.
+func (v EtherBalanceOf) Encode() []byte {
+	return EncodeEtherBalanceOf(v.Account)
+}
+
+func _etherBalanceOf_Decode(values []any) (any, error) {
+	if len(values) != 1 {
+		return nil, fmt.Errorf("wrong number of values")
+	}
+	var ok bool
+	var v EtherBalanceOf
+	v.Account, ok = values[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("failed to unpack etherBalanceOf.account")
+	}
+	return v, nil
+}
+
+// Report with the Ether balance of an account in the DApp wallet.
+type EtherBalance struct {
+	Account common.Address
+	Balance *big.Int
+}
+
+// ID for the Ether balance report.
+var EtherBalanceID ID
+
+// Encode the Ether balance report into binary data.
+func EncodeEtherBalance(Account common.Address, Balance *big.Int) []byte {
+	values := make([]any, 2)
+	values[0] = Account
+	values[1] = Balance
+	data, err := _abi.Methods["etherBalance"].Inputs.PackValues(values)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode etherBalance: %v", err))
+	}
+	return append(EtherBalanceID[:], data...)
+}
+
+// Encode the Ether balance report into binary data.
+func (v EtherBalance) Encode() []byte {
+	return EncodeEtherBalance(v.Account, v.Balance)
+}
+
+func _etherBalance_Decode(values []any) (any, error) {
+	if len(values) != 2 {
+		return nil, fmt.Errorf("wrong number of values")
+	}
+	var ok bool
+	var v EtherBalance
+	v.Account, ok = values[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("failed to unpack etherBalance.account")
+	}
+	v.Balance, ok = values[1].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("failed to unpack etherBalance.balance")
+	}
+	return v, nil
+}
+
+// Inspect message that requests the ERC20 balance of an account in the DApp wallet.
+// EggRoll answers it with an ERC20Balance report, without calling the contract.
+type ERC20BalanceOf struct {
+	Token   common.Address
+	Account common.Address
+}
+
+// ID for the ERC20 balance request.
+var ERC20BalanceOfID ID
+
+// Encode the ERC20 balance request into binary data.
+func EncodeERC20BalanceOf(Token common.Address, Account common.Address) []byte {
+	values := make([]any, 2)
+	values[0] = Token
+	values[1] = Account
+	data, err := _abi.Methods["erc20BalanceOf"].Inputs.PackValues(values)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode erc20BalanceOf: %v", err))
+	}
+	return append(ERC20BalanceOfID[:], data...)
+}
+
+// Encode the ERC20 balance request into binary data.
+func (v ERC20BalanceOf) Encode() []byte {
+	return EncodeERC20BalanceOf(v.Token, v.Account)
+}
+
+func _erc20BalanceOf_Decode(values []any) (any, error) {
+	if len(values) != 2 {
+		return nil, fmt.Errorf("wrong number of values")
+	}
+	var ok bool
+	var v ERC20BalanceOf
+	v.Token, ok = values[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("failed to unpack erc20BalanceOf.token")
+	}
+	v.Account, ok = values[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("failed to unpack erc20BalanceOf.account")
+	}
+	return v, nil
+}
+
+// Report with the ERC20 balance of an account in the DApp wallet.
+type ERC20Balance struct {
+	Token   common.Address
+	Account common.Address
+	Balance *big.Int
+}
+
+// ID for the ERC20 balance report.
+var ERC20BalanceID ID
+
+// Encode the ERC20 balance report into binary data.
+func EncodeERC20Balance(Token common.Address, Account common.Address, Balance *big.Int) []byte {
+	values := make([]any, 3)
+	values[0] = Token
+	values[1] = Account
+	values[2] = Balance
+	data, err := _abi.Methods["erc20Balance"].Inputs.PackValues(values)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode erc20Balance: %v", err))
+	}
+	return append(ERC20BalanceID[:], data...)
+}
+
+// Encode the ERC20 balance report into binary data.
+func (v ERC20Balance) Encode() []byte {
+	return EncodeERC20Balance(v.Token, v.Account, v.Balance)
+}
+
+func _erc20Balance_Decode(values []any) (any, error) {
+	if len(values) != 3 {
+		return nil, fmt.Errorf("wrong number of values")
+	}
+	var ok bool
+	var v ERC20Balance
+	v.Token, ok = values[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("failed to unpack erc20Balance.token")
+	}
+	v.Account, ok = values[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("failed to unpack erc20Balance.account")
+	}
+	v.Balance, ok = values[2].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("failed to unpack erc20Balance.balance")
+	}
+	return v, nil
+}
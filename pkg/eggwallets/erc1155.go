@@ -0,0 +1,462 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggwallets
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// An ERC1155 deposit that arrived from the single portal.
+type ERC1155SingleDeposit struct {
+	Token   common.Address
+	Sender  common.Address
+	TokenId *big.Int
+	Value   *big.Int
+}
+
+func (d *ERC1155SingleDeposit) GetSender() common.Address {
+	return d.Sender
+}
+
+func (d *ERC1155SingleDeposit) String() string {
+	token := strings.ToLower(d.Token.String())
+	sender := strings.ToLower(d.Sender.String())
+	return fmt.Sprintf("%v deposited %v of %v token with id %v", sender, d.Value, token, d.TokenId)
+}
+
+// An ERC1155 deposit that arrived from the batch portal.
+type ERC1155BatchDeposit struct {
+	Token    common.Address
+	Sender   common.Address
+	TokenIds []*big.Int
+	Values   []*big.Int
+}
+
+func (d *ERC1155BatchDeposit) GetSender() common.Address {
+	return d.Sender
+}
+
+func (d *ERC1155BatchDeposit) String() string {
+	token := strings.ToLower(d.Token.String())
+	sender := strings.ToLower(d.Sender.String())
+	return fmt.Sprintf("%v deposited %v of %v token with ids %v", sender, d.Values, token, d.TokenIds)
+}
+
+// Previous balance of an address for a token id; used to restore the wallet.
+type erc1155JournalEntry struct {
+	token   common.Address
+	tokenId common.Hash
+	address common.Address
+	balance big.Int
+}
+
+// Wallet that manages ERC1155 tokens.
+// The same wallet handles the deposits from the single and batch portals.
+type ERC1155Wallet struct {
+	// Map from the token address, to the token id, to the address balance.
+	balance map[common.Address]map[common.Hash]map[common.Address]big.Int
+
+	// Balance changes since the last snapshot.
+	journal   []erc1155JournalEntry
+	journaled bool
+}
+
+// Create new ERC1155 Wallet.
+func NewERC1155Wallet() *ERC1155Wallet {
+	return &ERC1155Wallet{
+		balance: make(map[common.Address]map[common.Hash]map[common.Address]big.Int),
+	}
+}
+
+// Return the list of tokens with assets.
+func (w *ERC1155Wallet) Tokens() []common.Address {
+	var tokens []common.Address
+	for t := range w.balance {
+		tokens = append(tokens, t)
+	}
+	sortAddresses(tokens)
+	return tokens
+}
+
+// Return the sorted list of token ids the given address has balance of.
+func (w *ERC1155Wallet) TokenIdsOf(token common.Address, address common.Address) []*big.Int {
+	var tokenIds []*big.Int
+	for id, balances := range w.balance[token] {
+		if _, ok := balances[address]; ok {
+			tokenIds = append(tokenIds, id.Big())
+		}
+	}
+	sortBigInts(tokenIds)
+	return tokenIds
+}
+
+// Return the balance of the given address for the given token id.
+func (w *ERC1155Wallet) BalanceOf(
+	token common.Address, address common.Address, tokenId *big.Int) *big.Int {
+
+	balance := w.balance[token][common.BigToHash(tokenId)][address]
+	return &balance
+}
+
+func (w *ERC1155Wallet) setBalance(
+	token common.Address, address common.Address, tokenId *big.Int, value *big.Int) {
+
+	id := common.BigToHash(tokenId)
+	if w.journaled {
+		entry := erc1155JournalEntry{token, id, address, w.balance[token][id][address]}
+		w.journal = append(w.journal, entry)
+	}
+	w.updateBalance(token, address, id, value)
+}
+
+func (w *ERC1155Wallet) updateBalance(
+	token common.Address, address common.Address, tokenId common.Hash, value *big.Int) {
+
+	if value.Sign() == 0 {
+		if w.balance[token][tokenId] != nil {
+			delete(w.balance[token][tokenId], address)
+			if len(w.balance[token][tokenId]) == 0 {
+				delete(w.balance[token], tokenId)
+			}
+			if len(w.balance[token]) == 0 {
+				delete(w.balance, token)
+			}
+		}
+	} else {
+		if w.balance[token] == nil {
+			w.balance[token] = make(map[common.Hash]map[common.Address]big.Int)
+		}
+		if w.balance[token][tokenId] == nil {
+			w.balance[token][tokenId] = make(map[common.Address]big.Int)
+		}
+		w.balance[token][tokenId][address] = *value
+	}
+}
+
+// Start recording the balance changes, so they can be undone by Restore.
+func (w *ERC1155Wallet) Snapshot() {
+	w.journal = nil
+	w.journaled = true
+}
+
+// Undo the balance changes since the last snapshot.
+func (w *ERC1155Wallet) Restore() {
+	for i := len(w.journal) - 1; i >= 0; i-- {
+		entry := w.journal[i]
+		w.updateBalance(entry.token, entry.address, entry.tokenId, &entry.balance)
+	}
+	w.journal = nil
+}
+
+// Sum the values for each token id, checking the batch is well formed.
+func sumBatch(tokenIds []*big.Int, values []*big.Int) (map[common.Hash]*big.Int, error) {
+	if len(tokenIds) != len(values) {
+		return nil, fmt.Errorf("token ids and values mismatch")
+	}
+	sum := make(map[common.Hash]*big.Int)
+	for i, tokenId := range tokenIds {
+		if values[i].Sign() < 0 {
+			return nil, fmt.Errorf("negative value")
+		}
+		id := common.BigToHash(tokenId)
+		if sum[id] == nil {
+			sum[id] = new(big.Int)
+		}
+		sum[id].Add(sum[id], values[i])
+	}
+	return sum, nil
+}
+
+// Transfer the given amounts of token ids from source to destination.
+// Return error if the source doesn't have enough funds for any of the token ids.
+func (w *ERC1155Wallet) Transfer(
+	token common.Address,
+	src common.Address,
+	dst common.Address,
+	tokenIds []*big.Int,
+	values []*big.Int,
+) error {
+
+	if src == dst {
+		return fmt.Errorf("can't transfer to self")
+	}
+	sum, err := sumBatch(tokenIds, values)
+	if err != nil {
+		return err
+	}
+
+	// check every token id before changing the balances
+	newSrcBalances := make(map[common.Hash]*big.Int)
+	newDstBalances := make(map[common.Hash]*big.Int)
+	for id, value := range sum {
+		tokenId := id.Big()
+		newSrcBalances[id] = new(big.Int).Sub(w.BalanceOf(token, src, tokenId), value)
+		if newSrcBalances[id].Sign() < 0 {
+			return fmt.Errorf("insuficient funds")
+		}
+		newDstBalances[id] = new(big.Int).Add(w.BalanceOf(token, dst, tokenId), value)
+		if newDstBalances[id].Cmp(MaxUint256) > 0 {
+			return fmt.Errorf("balance overflow")
+		}
+	}
+
+	// commit
+	for id := range sum {
+		tokenId := id.Big()
+		w.setBalance(token, src, tokenId, newSrcBalances[id])
+		w.setBalance(token, dst, tokenId, newDstBalances[id])
+	}
+	return nil
+}
+
+// Encode the single withdraw request to the token contract.
+// The DApp contract transfers the tokens to the given address.
+func EncodeERC1155SingleWithdraw(
+	dappAddress common.Address, address common.Address, tokenId *big.Int, value *big.Int,
+) []byte {
+
+	abiJson := `[{
+		"type": "function",
+		"name": "safeTransferFrom",
+		"inputs": [
+			{"type": "address"},
+			{"type": "address"},
+			{"type": "uint256"},
+			{"type": "uint256"},
+			{"type": "bytes"}
+		]
+	}]`
+	abiInterface, err := abi.JSON(strings.NewReader(abiJson))
+	if err != nil {
+		log.Panicf("failed to decode ABI: %v", err)
+	}
+	voucher, err := abiInterface.Pack(
+		"safeTransferFrom", dappAddress, address, tokenId, value, []byte{})
+	if err != nil {
+		log.Panicf("failed to pack: %v", err)
+	}
+	return voucher
+}
+
+// Encode the batch withdraw request to the token contract.
+// The DApp contract transfers the tokens to the given address.
+func EncodeERC1155BatchWithdraw(
+	dappAddress common.Address, address common.Address, tokenIds []*big.Int, values []*big.Int,
+) []byte {
+
+	abiJson := `[{
+		"type": "function",
+		"name": "safeBatchTransferFrom",
+		"inputs": [
+			{"type": "address"},
+			{"type": "address"},
+			{"type": "uint256[]"},
+			{"type": "uint256[]"},
+			{"type": "bytes"}
+		]
+	}]`
+	abiInterface, err := abi.JSON(strings.NewReader(abiJson))
+	if err != nil {
+		log.Panicf("failed to decode ABI: %v", err)
+	}
+	voucher, err := abiInterface.Pack(
+		"safeBatchTransferFrom", dappAddress, address, tokenIds, values, []byte{})
+	if err != nil {
+		log.Panicf("failed to pack: %v", err)
+	}
+	return voucher
+}
+
+// Remove the given amounts of token ids from the address.
+func (w *ERC1155Wallet) withdraw(
+	token common.Address, address common.Address, tokenIds []*big.Int, values []*big.Int,
+) error {
+
+	sum, err := sumBatch(tokenIds, values)
+	if err != nil {
+		return err
+	}
+	newBalances := make(map[common.Hash]*big.Int)
+	for id, value := range sum {
+		newBalances[id] = new(big.Int).Sub(w.BalanceOf(token, address, id.Big()), value)
+		if newBalances[id].Sign() < 0 {
+			return fmt.Errorf("insuficient funds")
+		}
+	}
+	for id, balance := range newBalances {
+		w.setBalance(token, address, id.Big(), balance)
+	}
+	return nil
+}
+
+// Withdraw the asset from the wallet and generate the voucher to withdraw from the token
+// contract. The voucher destination should be the token address.
+// Return error if the address doesn't have enough assets.
+func (w *ERC1155Wallet) WithdrawSingle(
+	dappAddress common.Address,
+	token common.Address,
+	address common.Address,
+	tokenId *big.Int,
+	value *big.Int,
+) ([]byte, error) {
+
+	err := w.withdraw(token, address, []*big.Int{tokenId}, []*big.Int{value})
+	if err != nil {
+		return nil, err
+	}
+	return EncodeERC1155SingleWithdraw(dappAddress, address, tokenId, value), nil
+}
+
+// Withdraw the assets from the wallet and generate the voucher to withdraw from the token
+// contract. The voucher destination should be the token address.
+// Return error if the address doesn't have enough assets.
+func (w *ERC1155Wallet) WithdrawBatch(
+	dappAddress common.Address,
+	token common.Address,
+	address common.Address,
+	tokenIds []*big.Int,
+	values []*big.Int,
+) ([]byte, error) {
+
+	if len(tokenIds) == 0 {
+		return nil, fmt.Errorf("no token ids")
+	}
+	err := w.withdraw(token, address, tokenIds, values)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeERC1155BatchWithdraw(dappAddress, address, tokenIds, values), nil
+}
+
+// Add the deposited value to the sender balance.
+func (w *ERC1155Wallet) deposit(
+	token common.Address, sender common.Address, tokenId *big.Int, value *big.Int) {
+
+	newBalance := new(big.Int).Add(w.BalanceOf(token, sender, tokenId), value)
+	if newBalance.Cmp(MaxUint256) > 0 {
+		// This should not be possible in real world, but we handle it anyway.
+		newBalance = MaxUint256
+	}
+	w.setBalance(token, sender, tokenId, newBalance)
+}
+
+// Decode a deposit from the ERC1155 single portal without changing any wallet.
+// Return the deposit and the execution layer data.
+func DecodeERC1155SingleDeposit(payload []byte) (*ERC1155SingleDeposit, []byte, error) {
+	if len(payload) < 20+20+32+32 {
+		return nil, nil, fmt.Errorf("invalid erc1155 single deposit size; got %v", len(payload))
+	}
+
+	token := common.BytesToAddress(payload[:20])
+	payload = payload[20:]
+
+	sender := common.BytesToAddress(payload[:20])
+	payload = payload[20:]
+
+	tokenId := new(big.Int).SetBytes(payload[:32])
+	payload = payload[32:]
+
+	value := new(big.Int).SetBytes(payload[:32])
+	payload = payload[32:]
+
+	_, execLayerData, err := decodeLayerData(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid erc1155 single deposit: %v", err)
+	}
+
+	return &ERC1155SingleDeposit{token, sender, tokenId, value}, execLayerData, nil
+}
+
+// Handle a deposit from the ERC1155 single portal.
+func (w *ERC1155Wallet) DepositSingle(payload []byte) (Deposit, []byte, error) {
+	deposit, execLayerData, err := DecodeERC1155SingleDeposit(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w.deposit(deposit.Token, deposit.Sender, deposit.TokenId, deposit.Value)
+
+	return deposit, execLayerData, nil
+}
+
+// Decode a deposit from the ERC1155 batch portal without changing any wallet.
+// Return the deposit and the execution layer data.
+func DecodeERC1155BatchDeposit(payload []byte) (*ERC1155BatchDeposit, []byte, error) {
+	if len(payload) < 20+20 {
+		return nil, nil, fmt.Errorf("invalid erc1155 batch deposit size; got %v", len(payload))
+	}
+
+	token := common.BytesToAddress(payload[:20])
+	payload = payload[20:]
+
+	sender := common.BytesToAddress(payload[:20])
+	payload = payload[20:]
+
+	uint256ArrayType, _ := abi.NewType("uint256[]", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	args := abi.Arguments{
+		{Type: uint256ArrayType},
+		{Type: uint256ArrayType},
+		{Type: bytesType},
+		{Type: bytesType},
+	}
+	values, err := args.UnpackValues(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid erc1155 batch deposit: %v", err)
+	}
+	tokenIds := values[0].([]*big.Int)
+	amounts := values[1].([]*big.Int)
+	execLayerData := values[3].([]byte)
+	if len(tokenIds) != len(amounts) {
+		return nil, nil, fmt.Errorf("invalid erc1155 batch deposit: token ids and values mismatch")
+	}
+
+	return &ERC1155BatchDeposit{token, sender, tokenIds, amounts}, execLayerData, nil
+}
+
+// Handle a deposit from the ERC1155 batch portal.
+func (w *ERC1155Wallet) DepositBatch(payload []byte) (Deposit, []byte, error) {
+	deposit, execLayerData, err := DecodeERC1155BatchDeposit(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range deposit.TokenIds {
+		w.deposit(deposit.Token, deposit.Sender, deposit.TokenIds[i], deposit.Values[i])
+	}
+
+	return deposit, execLayerData, nil
+}
+
+// Return the wallet that handles deposits from the ERC1155 single portal.
+func (w *ERC1155Wallet) SinglePortalWallet() Wallet {
+	return erc1155SinglePortalWallet{w}
+}
+
+// Return the wallet that handles deposits from the ERC1155 batch portal.
+func (w *ERC1155Wallet) BatchPortalWallet() Wallet {
+	return erc1155BatchPortalWallet{w}
+}
+
+type erc1155SinglePortalWallet struct {
+	*ERC1155Wallet
+}
+
+func (w erc1155SinglePortalWallet) Deposit(payload []byte) (Deposit, []byte, error) {
+	return w.DepositSingle(payload)
+}
+
+type erc1155BatchPortalWallet struct {
+	*ERC1155Wallet
+}
+
+func (w erc1155BatchPortalWallet) Deposit(payload []byte) (Deposit, []byte, error) {
+	return w.DepositBatch(payload)
+}
@@ -0,0 +1,208 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggwallets
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestERC1155DepositString(t *testing.T) {
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	single := &ERC1155SingleDeposit{token, sender, big.NewInt(1), big.NewInt(10)}
+	expectedString := "0xfafafafafafafafafafafafafafafafafafafafa deposited 10 of 0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef token with id 1"
+	if single.String() != expectedString {
+		t.Fatalf("wrong deposit string: %v", single.String())
+	}
+	batch := &ERC1155BatchDeposit{
+		token, sender, []*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(10), big.NewInt(20)},
+	}
+	expectedString = "0xfafafafafafafafafafafafafafafafafafafafa deposited [10 20] of 0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef token with ids [1 2]"
+	if batch.String() != expectedString {
+		t.Fatalf("wrong deposit string: %v", batch.String())
+	}
+}
+
+func TestERC1155Transfer(t *testing.T) {
+	wallet := NewERC1155Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	src := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	dst := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet.setBalance(token, src, big.NewInt(1), big.NewInt(50))
+	wallet.setBalance(token, src, big.NewInt(2), big.NewInt(50))
+	err := wallet.Transfer(token, src, dst,
+		[]*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(50), big.NewInt(20)})
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if wallet.BalanceOf(token, src, big.NewInt(2)).Cmp(big.NewInt(30)) != 0 {
+		t.Fatalf("expected 30 balance in src")
+	}
+	if wallet.BalanceOf(token, dst, big.NewInt(1)).Cmp(big.NewInt(50)) != 0 {
+		t.Fatalf("expected 50 balance in dst")
+	}
+	tokenIds := wallet.TokenIdsOf(token, src)
+	if !reflect.DeepEqual([]*big.Int{big.NewInt(2)}, tokenIds) {
+		t.Fatalf("wrong token ids: %v", tokenIds)
+	}
+}
+
+func TestInsuficientFundsERC1155Transfer(t *testing.T) {
+	wallet := NewERC1155Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	src := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	dst := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet.setBalance(token, src, big.NewInt(1), big.NewInt(50))
+	wallet.setBalance(token, src, big.NewInt(2), big.NewInt(50))
+	// the second id has enough funds for each entry, but not for the sum
+	err := wallet.Transfer(token, src, dst,
+		[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(2)},
+		[]*big.Int{big.NewInt(10), big.NewInt(30), big.NewInt(30)})
+	if err == nil {
+		t.Fatalf("expected error; got nil")
+	}
+	if err.Error() != "insuficient funds" {
+		t.Fatalf("wrong error message: %v", err)
+	}
+	if wallet.BalanceOf(token, src, big.NewInt(1)).Cmp(big.NewInt(50)) != 0 {
+		t.Fatalf("expected 50 balance in src")
+	}
+	if len(wallet.TokenIdsOf(token, dst)) != 0 {
+		t.Fatalf("expected no token ids in dst")
+	}
+}
+
+func TestERC1155WithdrawEncode(t *testing.T) {
+	dapp := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	address := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	voucher := EncodeERC1155SingleWithdraw(dapp, address, big.NewInt(1), big.NewInt(100))
+	expectedVoucher := common.Hex2Bytes("f242432a" +
+		"000000000000000000000000fafafafafafafafafafafafafafafafafafafafa" +
+		"000000000000000000000000fefefefefefefefefefefefefefefefefefefefe" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000064" +
+		"00000000000000000000000000000000000000000000000000000000000000a0" +
+		"0000000000000000000000000000000000000000000000000000000000000000")
+	if !bytes.Equal(voucher, expectedVoucher) {
+		t.Fatalf("got wrong voucher: %x", voucher)
+	}
+	voucher = EncodeERC1155BatchWithdraw(dapp, address, []*big.Int{big.NewInt(1)}, []*big.Int{big.NewInt(100)})
+	if !bytes.Equal(voucher[:4], common.Hex2Bytes("2eb2c2d6")) {
+		t.Fatalf("got wrong voucher selector: %x", voucher[:4])
+	}
+}
+
+func TestERC1155Withdraw(t *testing.T) {
+	wallet := NewERC1155Wallet()
+	dapp := common.HexToAddress("0xdeaddeaddeaddeaddeaddeaddeaddeaddeaddead")
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	address := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	wallet.setBalance(token, address, big.NewInt(1), big.NewInt(50))
+	wallet.setBalance(token, address, big.NewInt(2), big.NewInt(50))
+	voucher, err := wallet.WithdrawSingle(dapp, token, address, big.NewInt(1), big.NewInt(100))
+	if voucher != nil || err == nil {
+		t.Fatalf("expected nil, err; got %v, %v", voucher, err)
+	}
+	voucher, err = wallet.WithdrawBatch(dapp, token, address,
+		[]*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(50), big.NewInt(50)})
+	if voucher == nil || err != nil {
+		t.Fatalf("expected voucher, nil; got %v, %v", voucher, err)
+	}
+	if len(wallet.Tokens()) != 0 {
+		t.Fatalf("expected no tokens")
+	}
+}
+
+func TestERC1155DepositSingle(t *testing.T) {
+	wallet := NewERC1155Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	payload := common.Hex2Bytes("beefbeefbeefbeefbeefbeefbeefbeefbeefbeef" +
+		"fafafafafafafafafafafafafafafafafafafafa" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"000000000000000000000000000000000000000000000000000000000000000a" +
+		// abi.encode(0x, 0xc0ffee)
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+		"0000000000000000000000000000000000000000000000000000000000000060" +
+		"0000000000000000000000000000000000000000000000000000000000000000" +
+		"0000000000000000000000000000000000000000000000000000000000000003" +
+		"c0ffee0000000000000000000000000000000000000000000000000000000000")
+	deposit, input, err := wallet.SinglePortalWallet().Deposit(payload)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	expectedDeposit := &ERC1155SingleDeposit{token, sender, big.NewInt(1), big.NewInt(10)}
+	if !reflect.DeepEqual(expectedDeposit, deposit) {
+		t.Fatalf("wrong deposit: %v", deposit)
+	}
+	if !bytes.Equal(input, common.Hex2Bytes("c0ffee")) {
+		t.Fatalf("wrong input: %x", input)
+	}
+	if wallet.BalanceOf(token, sender, big.NewInt(1)).Cmp(big.NewInt(10)) != 0 {
+		t.Fatalf("expected 10 balance")
+	}
+}
+
+func TestERC1155DepositBatch(t *testing.T) {
+	wallet := NewERC1155Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	payload := common.Hex2Bytes("beefbeefbeefbeefbeefbeefbeefbeefbeefbeef" +
+		"fafafafafafafafafafafafafafafafafafafafa" +
+		// abi.encode([1, 2], [10, 20], 0x, 0xc0ffee)
+		"0000000000000000000000000000000000000000000000000000000000000080" +
+		"00000000000000000000000000000000000000000000000000000000000000e0" +
+		"0000000000000000000000000000000000000000000000000000000000000140" +
+		"0000000000000000000000000000000000000000000000000000000000000160" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"000000000000000000000000000000000000000000000000000000000000000a" +
+		"0000000000000000000000000000000000000000000000000000000000000014" +
+		"0000000000000000000000000000000000000000000000000000000000000000" +
+		"0000000000000000000000000000000000000000000000000000000000000003" +
+		"c0ffee0000000000000000000000000000000000000000000000000000000000")
+	deposit, input, err := wallet.BatchPortalWallet().Deposit(payload)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	expectedDeposit := &ERC1155BatchDeposit{
+		token, sender, []*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(10), big.NewInt(20)},
+	}
+	if !reflect.DeepEqual(expectedDeposit, deposit) {
+		t.Fatalf("wrong deposit: %v", deposit)
+	}
+	if !bytes.Equal(input, common.Hex2Bytes("c0ffee")) {
+		t.Fatalf("wrong input: %x", input)
+	}
+	if wallet.BalanceOf(token, sender, big.NewInt(2)).Cmp(big.NewInt(20)) != 0 {
+		t.Fatalf("expected 20 balance")
+	}
+}
+
+func TestERC1155Restore(t *testing.T) {
+	wallet := NewERC1155Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	src := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	dst := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet.setBalance(token, src, big.NewInt(1), big.NewInt(50))
+	wallet.Snapshot()
+	err := wallet.Transfer(token, src, dst, []*big.Int{big.NewInt(1)}, []*big.Int{big.NewInt(50)})
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	wallet.Restore()
+	if wallet.BalanceOf(token, src, big.NewInt(1)).Cmp(big.NewInt(50)) != 0 {
+		t.Fatalf("expected 50 balance in src")
+	}
+	if len(wallet.TokenIdsOf(token, dst)) != 0 {
+		t.Fatalf("expected no token ids in dst")
+	}
+}
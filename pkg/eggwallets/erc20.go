@@ -31,9 +31,20 @@ func (d *ERC20Deposit) String() string {
 	return fmt.Sprintf("%v deposited %v of %v token", sender, value, token)
 }
 
+// Previous balance of an address for a token; used to restore the wallet.
+type erc20JournalEntry struct {
+	token   common.Address
+	address common.Address
+	balance big.Int
+}
+
 // Wallet that manages ERC20 tokens.
 type ERC20Wallet struct {
 	balance map[common.Address]map[common.Address]big.Int
+
+	// Balance changes since the last snapshot.
+	journal   []erc20JournalEntry
+	journaled bool
 }
 
 // Create new ERC20 Wallet.
@@ -64,6 +75,14 @@ func (w *ERC20Wallet) Addresses(token common.Address) []common.Address {
 }
 
 func (w *ERC20Wallet) setBalance(token common.Address, address common.Address, value *big.Int) {
+	if w.journaled {
+		entry := erc20JournalEntry{token, address, w.balance[token][address]}
+		w.journal = append(w.journal, entry)
+	}
+	w.updateBalance(token, address, value)
+}
+
+func (w *ERC20Wallet) updateBalance(token common.Address, address common.Address, value *big.Int) {
 	if value.Sign() == 0 {
 		if w.balance[token] != nil {
 			delete(w.balance[token], address)
@@ -79,6 +98,21 @@ func (w *ERC20Wallet) setBalance(token common.Address, address common.Address, v
 	}
 }
 
+// Start recording the balance changes, so they can be undone by Restore.
+func (w *ERC20Wallet) Snapshot() {
+	w.journal = nil
+	w.journaled = true
+}
+
+// Undo the balance changes since the last snapshot.
+func (w *ERC20Wallet) Restore() {
+	for i := len(w.journal) - 1; i >= 0; i-- {
+		entry := w.journal[i]
+		w.updateBalance(entry.token, entry.address, &entry.balance)
+	}
+	w.journal = nil
+}
+
 // Return the balance of the given address for the given token.
 func (w *ERC20Wallet) BalanceOf(token common.Address, address common.Address) *big.Int {
 	balance := w.balance[token][address]
@@ -110,8 +144,9 @@ func (w *ERC20Wallet) Transfer(
 	return nil
 }
 
-// Encode the withdraw request to the portal.
-func EncodeERC20Withdraw(token common.Address, value *big.Int) []byte {
+// Encode the withdraw request to the token contract.
+// The DApp contract transfers the given amount of tokens to the recipient.
+func EncodeERC20Withdraw(recipient common.Address, value *big.Int) []byte {
 	abiJson := `[{
 		"type": "function",
 		"name": "transfer",
@@ -124,14 +159,15 @@ func EncodeERC20Withdraw(token common.Address, value *big.Int) []byte {
 	if err != nil {
 		log.Panicf("failed to decode ABI: %v", err)
 	}
-	voucher, err := abiInterface.Pack("transfer", token, value)
+	voucher, err := abiInterface.Pack("transfer", recipient, value)
 	if err != nil {
 		log.Panicf("failed to pack: %v", err)
 	}
 	return voucher
 }
 
-// Withdraw the asset from the wallet and generate the voucher to withdraw from the portal.
+// Withdraw the asset from the wallet and generate the voucher to withdraw from the token
+// contract. The voucher destination should be the token address.
 // Return error if the address doesn't have enough assets.
 func (w *ERC20Wallet) Withdraw(
 	token common.Address, address common.Address, value *big.Int) ([]byte, error) {
@@ -144,8 +180,9 @@ func (w *ERC20Wallet) Withdraw(
 	return EncodeERC20Withdraw(address, value), nil
 }
 
-// Handle a deposit from the ERC20 portal.
-func (w *ERC20Wallet) Deposit(payload []byte) (Deposit, []byte, error) {
+// Decode a deposit from the ERC20 portal without changing any wallet.
+// Return the deposit and the remaining payload.
+func DecodeERC20Deposit(payload []byte) (*ERC20Deposit, []byte, error) {
 	if len(payload) < 1+20+20+32 {
 		return nil, nil, fmt.Errorf("invalid erc20 deposit size; got %v", len(payload))
 	}
@@ -164,13 +201,22 @@ func (w *ERC20Wallet) Deposit(payload []byte) (Deposit, []byte, error) {
 	amount := new(big.Int).SetBytes(payload[:32])
 	payload = payload[32:]
 
-	newBalance := new(big.Int).Add(w.BalanceOf(token, sender), amount)
+	return &ERC20Deposit{token, sender, amount}, payload, nil
+}
+
+// Handle a deposit from the ERC20 portal.
+func (w *ERC20Wallet) Deposit(payload []byte) (Deposit, []byte, error) {
+	deposit, payload, err := DecodeERC20Deposit(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newBalance := new(big.Int).Add(w.BalanceOf(deposit.Token, deposit.Sender), deposit.Amount)
 	if newBalance.Cmp(MaxUint256) > 0 {
 		// This should not be possible in real world, but we handle it anyway.
 		newBalance = MaxUint256
 	}
-	w.setBalance(token, sender, newBalance)
+	w.setBalance(deposit.Token, deposit.Sender, newBalance)
 
-	deposit := &ERC20Deposit{token, sender, amount}
 	return deposit, payload, nil
 }
@@ -7,9 +7,12 @@ import (
 	"bytes"
 	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/eggroll/pkg/eggeth/bindings"
 )
 
 func TestERC20DepositString(t *testing.T) {
@@ -217,10 +220,41 @@ func TestBalanceOverflowERC20Transfer(t *testing.T) {
 	}
 }
 
+func TestERC20Restore(t *testing.T) {
+	wallet := NewERC20Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	src := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	dst := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet.setBalance(token, src, big.NewInt(50))
+	wallet.Snapshot()
+	err := wallet.Transfer(token, src, dst, big.NewInt(50))
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	anotherToken := common.HexToAddress("0xfeebfeebfeebfeebfeebfeebfeebfeebfeebfeeb")
+	wallet.setBalance(anotherToken, dst, big.NewInt(100))
+	wallet.Restore()
+	srcBalance := wallet.BalanceOf(token, src)
+	if srcBalance.Cmp(big.NewInt(50)) != 0 {
+		t.Fatalf("expected 50 balance in src")
+	}
+	dstBalance := wallet.BalanceOf(token, dst)
+	if dstBalance.Sign() != 0 {
+		t.Fatalf("expected 0 balance in dst")
+	}
+	tokens := wallet.Tokens()
+	expectedTokens := []common.Address{
+		token,
+	}
+	if !reflect.DeepEqual(expectedTokens, tokens) {
+		t.Fatalf("wrong tokens: %+v", tokens)
+	}
+}
+
 func TestERC20WithdrawEncode(t *testing.T) {
-	token := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	recipient := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
 	value := big.NewInt(100)
-	voucher := EncodeERC20Withdraw(token, value)
+	voucher := EncodeERC20Withdraw(recipient, value)
 	expectedVoucher := common.Hex2Bytes("a9059cbb000000000000000000000000fafafafafafafafafafafafafafafafafafafafa0000000000000000000000000000000000000000000000000000000000000064")
 	if !bytes.Equal(voucher, expectedVoucher) {
 		t.Fatalf("got wrong voucher: %x", voucher)
@@ -242,6 +276,38 @@ func TestValidERC20Withdraw(t *testing.T) {
 	}
 }
 
+func TestERC20WithdrawVoucher(t *testing.T) {
+	wallet := NewERC20Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	address := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	wallet.setBalance(token, address, big.NewInt(100))
+	voucher, err := wallet.Withdraw(token, address, big.NewInt(60))
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	erc20Abi, err := abi.JSON(strings.NewReader(bindings.IERC20MetaData.ABI))
+	if err != nil {
+		t.Fatalf("failed to parse abi: %v", err)
+	}
+	method, err := erc20Abi.MethodById(voucher[:4])
+	if err != nil {
+		t.Fatalf("failed to get method: %v", err)
+	}
+	if method.Name != "transfer" {
+		t.Fatalf("wrong method: %v", method.Name)
+	}
+	args, err := method.Inputs.Unpack(voucher[4:])
+	if err != nil {
+		t.Fatalf("failed to unpack: %v", err)
+	}
+	if args[0].(common.Address) != address {
+		t.Fatalf("wrong recipient: %v", args[0])
+	}
+	if args[1].(*big.Int).Cmp(big.NewInt(60)) != 0 {
+		t.Fatalf("wrong amount: %v", args[1])
+	}
+}
+
 func TestInsuficientFundsERC20Withdraw(t *testing.T) {
 	wallet := NewERC20Wallet()
 	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
@@ -300,6 +366,29 @@ func TestValidERC20Deposit(t *testing.T) {
 	}
 }
 
+func TestDecodeERC20Deposit(t *testing.T) {
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	address := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	payload := common.Hex2Bytes("01beefbeefbeefbeefbeefbeefbeefbeefbeefbeeffafafafafafafafafafafafafafafafafafafafa0000000000000000000000000000000000000000000000000000000000000064deadbeef")
+	deposit, input, err := DecodeERC20Deposit(payload)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if deposit.Token != token || deposit.Sender != address ||
+		deposit.Amount.Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("wrong deposit: %v", deposit)
+	}
+	if common.Bytes2Hex(input) != "deadbeef" {
+		t.Fatal("wrong input")
+	}
+
+	payload[0] = 0
+	_, _, err = DecodeERC20Deposit(payload)
+	if err == nil || err.Error() != "received failed erc20 transfer" {
+		t.Fatalf("wrong error: %v", err)
+	}
+}
+
 func TestValidERC20DepositWithEmptyInput(t *testing.T) {
 	wallet := NewERC20Wallet()
 	payload := common.Hex2Bytes("01beefbeefbeefbeefbeefbeefbeefbeefbeefbeeffafafafafafafafafafafafafafafafafafafafa0000000000000000000000000000000000000000000000000000000000000064")
@@ -0,0 +1,216 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggwallets
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// An ERC721 deposit that arrived in the wallet.
+type ERC721Deposit struct {
+	Token   common.Address
+	Sender  common.Address
+	TokenId *big.Int
+}
+
+func (d *ERC721Deposit) GetSender() common.Address {
+	return d.Sender
+}
+
+func (d *ERC721Deposit) String() string {
+	token := strings.ToLower(d.Token.String())
+	sender := strings.ToLower(d.Sender.String())
+	return fmt.Sprintf("%v deposited %v token with id %v", sender, token, d.TokenId)
+}
+
+// Previous owner of a token id; used to restore the wallet.
+type erc721JournalEntry struct {
+	token   common.Address
+	tokenId common.Hash
+	owner   common.Address
+}
+
+// Wallet that manages ERC721 tokens.
+type ERC721Wallet struct {
+	// Map from the token address, to the token id, to the owner.
+	owners map[common.Address]map[common.Hash]common.Address
+
+	// Ownership changes since the last snapshot.
+	journal   []erc721JournalEntry
+	journaled bool
+}
+
+// Create new ERC721 Wallet.
+func NewERC721Wallet() *ERC721Wallet {
+	return &ERC721Wallet{
+		owners: make(map[common.Address]map[common.Hash]common.Address),
+	}
+}
+
+// Return the list of tokens with assets.
+func (w *ERC721Wallet) Tokens() []common.Address {
+	var tokens []common.Address
+	for t := range w.owners {
+		tokens = append(tokens, t)
+	}
+	sortAddresses(tokens)
+	return tokens
+}
+
+// Return the owner of the given token id.
+// Return the zero address if the token id is not in the wallet.
+func (w *ERC721Wallet) OwnerOf(token common.Address, tokenId *big.Int) common.Address {
+	return w.owners[token][common.BigToHash(tokenId)]
+}
+
+// Return the sorted list of token ids owned by the given address.
+func (w *ERC721Wallet) TokensOf(token common.Address, owner common.Address) []*big.Int {
+	var tokenIds []*big.Int
+	for id, o := range w.owners[token] {
+		if o == owner {
+			tokenIds = append(tokenIds, id.Big())
+		}
+	}
+	sortBigInts(tokenIds)
+	return tokenIds
+}
+
+func (w *ERC721Wallet) setOwner(token common.Address, tokenId *big.Int, owner common.Address) {
+	id := common.BigToHash(tokenId)
+	if w.journaled {
+		entry := erc721JournalEntry{token, id, w.owners[token][id]}
+		w.journal = append(w.journal, entry)
+	}
+	w.updateOwner(token, id, owner)
+}
+
+func (w *ERC721Wallet) updateOwner(token common.Address, tokenId common.Hash, owner common.Address) {
+	if owner == (common.Address{}) {
+		if w.owners[token] != nil {
+			delete(w.owners[token], tokenId)
+			if len(w.owners[token]) == 0 {
+				delete(w.owners, token)
+			}
+		}
+	} else {
+		if w.owners[token] == nil {
+			w.owners[token] = make(map[common.Hash]common.Address)
+		}
+		w.owners[token][tokenId] = owner
+	}
+}
+
+// Start recording the ownership changes, so they can be undone by Restore.
+func (w *ERC721Wallet) Snapshot() {
+	w.journal = nil
+	w.journaled = true
+}
+
+// Undo the ownership changes since the last snapshot.
+func (w *ERC721Wallet) Restore() {
+	for i := len(w.journal) - 1; i >= 0; i-- {
+		entry := w.journal[i]
+		w.updateOwner(entry.token, entry.tokenId, entry.owner)
+	}
+	w.journal = nil
+}
+
+// Transfer the token id from source to destination.
+// Return error if the source doesn't own the token id.
+func (w *ERC721Wallet) Transfer(
+	token common.Address, src common.Address, dst common.Address, tokenId *big.Int) error {
+
+	if src == dst {
+		return fmt.Errorf("can't transfer to self")
+	}
+	if dst == (common.Address{}) {
+		return fmt.Errorf("can't transfer to zero address")
+	}
+	if w.OwnerOf(token, tokenId) != src {
+		return fmt.Errorf("source doesn't own token")
+	}
+	w.setOwner(token, tokenId, dst)
+	return nil
+}
+
+// Encode the withdraw request to the token contract.
+// The DApp contract transfers the token to the given address.
+func EncodeERC721Withdraw(
+	dappAddress common.Address, address common.Address, tokenId *big.Int) []byte {
+
+	abiJson := `[{
+		"type": "function",
+		"name": "safeTransferFrom",
+		"inputs": [
+			{"type": "address"},
+			{"type": "address"},
+			{"type": "uint256"}
+		]
+	}]`
+	abiInterface, err := abi.JSON(strings.NewReader(abiJson))
+	if err != nil {
+		log.Panicf("failed to decode ABI: %v", err)
+	}
+	voucher, err := abiInterface.Pack("safeTransferFrom", dappAddress, address, tokenId)
+	if err != nil {
+		log.Panicf("failed to pack: %v", err)
+	}
+	return voucher
+}
+
+// Withdraw the asset from the wallet and generate the voucher to withdraw from the token
+// contract. The voucher destination should be the token address.
+// Return error if the address doesn't own the token id.
+func (w *ERC721Wallet) Withdraw(
+	dappAddress common.Address, token common.Address, address common.Address, tokenId *big.Int,
+) ([]byte, error) {
+
+	if w.OwnerOf(token, tokenId) != address {
+		return nil, fmt.Errorf("address doesn't own token")
+	}
+	w.setOwner(token, tokenId, common.Address{})
+	return EncodeERC721Withdraw(dappAddress, address, tokenId), nil
+}
+
+// Decode a deposit from the ERC721 portal without changing any wallet.
+// Return the deposit and the execution layer data.
+func DecodeERC721Deposit(payload []byte) (*ERC721Deposit, []byte, error) {
+	if len(payload) < 20+20+32 {
+		return nil, nil, fmt.Errorf("invalid erc721 deposit size; got %v", len(payload))
+	}
+
+	token := common.BytesToAddress(payload[:20])
+	payload = payload[20:]
+
+	sender := common.BytesToAddress(payload[:20])
+	payload = payload[20:]
+
+	tokenId := new(big.Int).SetBytes(payload[:32])
+	payload = payload[32:]
+
+	_, execLayerData, err := decodeLayerData(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid erc721 deposit: %v", err)
+	}
+
+	return &ERC721Deposit{token, sender, tokenId}, execLayerData, nil
+}
+
+// Handle a deposit from the ERC721 portal.
+func (w *ERC721Wallet) Deposit(payload []byte) (Deposit, []byte, error) {
+	deposit, execLayerData, err := DecodeERC721Deposit(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w.setOwner(deposit.Token, deposit.TokenId, deposit.Sender)
+
+	return deposit, execLayerData, nil
+}
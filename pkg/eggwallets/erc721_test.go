@@ -0,0 +1,155 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: MIT (see LICENSE)
+
+package eggwallets
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestERC721DepositString(t *testing.T) {
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	deposit := &ERC721Deposit{token, sender, big.NewInt(123)}
+	expectedString := "0xfafafafafafafafafafafafafafafafafafafafa deposited 0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef token with id 123"
+	if deposit.String() != expectedString {
+		t.Fatalf("wrong deposit string: %v", deposit.String())
+	}
+}
+
+func TestERC721TokensOf(t *testing.T) {
+	wallet := NewERC721Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	owner := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	other := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet.setOwner(token, big.NewInt(3), owner)
+	wallet.setOwner(token, big.NewInt(1), owner)
+	wallet.setOwner(token, big.NewInt(2), other)
+	tokenIds := wallet.TokensOf(token, owner)
+	expectedTokenIds := []*big.Int{big.NewInt(1), big.NewInt(3)}
+	if !reflect.DeepEqual(expectedTokenIds, tokenIds) {
+		t.Fatalf("wrong token ids: %v", tokenIds)
+	}
+	if wallet.OwnerOf(token, big.NewInt(2)) != other {
+		t.Fatalf("wrong owner")
+	}
+	if wallet.OwnerOf(token, big.NewInt(4)) != (common.Address{}) {
+		t.Fatalf("expected zero owner")
+	}
+}
+
+func TestValidERC721Transfer(t *testing.T) {
+	wallet := NewERC721Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	src := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	dst := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet.setOwner(token, big.NewInt(1), src)
+	err := wallet.Transfer(token, src, dst, big.NewInt(1))
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	if wallet.OwnerOf(token, big.NewInt(1)) != dst {
+		t.Fatalf("expected dst owner")
+	}
+}
+
+func TestNotOwnerERC721Transfer(t *testing.T) {
+	wallet := NewERC721Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	src := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	dst := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet.setOwner(token, big.NewInt(1), dst)
+	err := wallet.Transfer(token, src, dst, big.NewInt(1))
+	if err == nil {
+		t.Fatalf("expected error; got nil")
+	}
+	if err.Error() != "source doesn't own token" {
+		t.Fatalf("wrong error message: %v", err)
+	}
+}
+
+func TestERC721WithdrawEncode(t *testing.T) {
+	dapp := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	address := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	voucher := EncodeERC721Withdraw(dapp, address, big.NewInt(100))
+	expectedVoucher := common.Hex2Bytes("42842e0e000000000000000000000000fafafafafafafafafafafafafafafafafafafafa000000000000000000000000fefefefefefefefefefefefefefefefefefefefe0000000000000000000000000000000000000000000000000000000000000064")
+	if !bytes.Equal(voucher, expectedVoucher) {
+		t.Fatalf("got wrong voucher: %x", voucher)
+	}
+}
+
+func TestERC721Withdraw(t *testing.T) {
+	wallet := NewERC721Wallet()
+	dapp := common.HexToAddress("0xdeaddeaddeaddeaddeaddeaddeaddeaddeaddead")
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	address := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	other := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet.setOwner(token, big.NewInt(1), address)
+	voucher, err := wallet.Withdraw(dapp, token, other, big.NewInt(1))
+	if voucher != nil || err == nil {
+		t.Fatalf("expected nil, err; got %v, %v", voucher, err)
+	}
+	voucher, err = wallet.Withdraw(dapp, token, address, big.NewInt(1))
+	if voucher == nil || err != nil {
+		t.Fatalf("expected voucher, nil; got %v, %v", voucher, err)
+	}
+	if len(wallet.Tokens()) != 0 {
+		t.Fatalf("expected no tokens")
+	}
+}
+
+func TestERC721Deposit(t *testing.T) {
+	wallet := NewERC721Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	sender := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	payload := common.Hex2Bytes("beefbeefbeefbeefbeefbeefbeefbeefbeefbeef" +
+		"fafafafafafafafafafafafafafafafafafafafa" +
+		"000000000000000000000000000000000000000000000000000000000000007b" +
+		// abi.encode(0xdead, 0xc0ffee)
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+		"0000000000000000000000000000000000000000000000000000000000000080" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"dead000000000000000000000000000000000000000000000000000000000000" +
+		"0000000000000000000000000000000000000000000000000000000000000003" +
+		"c0ffee0000000000000000000000000000000000000000000000000000000000")
+	deposit, input, err := wallet.Deposit(payload)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	expectedDeposit := &ERC721Deposit{token, sender, big.NewInt(123)}
+	if !reflect.DeepEqual(expectedDeposit, deposit) {
+		t.Fatalf("wrong deposit: %v", deposit)
+	}
+	if !bytes.Equal(input, common.Hex2Bytes("c0ffee")) {
+		t.Fatalf("wrong input: %x", input)
+	}
+	if wallet.OwnerOf(token, big.NewInt(123)) != sender {
+		t.Fatalf("wrong owner")
+	}
+}
+
+func TestERC721Restore(t *testing.T) {
+	wallet := NewERC721Wallet()
+	token := common.HexToAddress("0xbeefbeefbeefbeefbeefbeefbeefbeefbeefbeef")
+	src := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	dst := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet.setOwner(token, big.NewInt(1), src)
+	wallet.Snapshot()
+	err := wallet.Transfer(token, src, dst, big.NewInt(1))
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	wallet.setOwner(token, big.NewInt(2), dst)
+	wallet.Restore()
+	if wallet.OwnerOf(token, big.NewInt(1)) != src {
+		t.Fatalf("expected src owner")
+	}
+	if wallet.OwnerOf(token, big.NewInt(2)) != (common.Address{}) {
+		t.Fatalf("expected zero owner")
+	}
+}
@@ -37,9 +37,19 @@ func (e *EtherDeposit) String() string {
 	return fmt.Sprintf("%v deposited %v Ether", sender, value)
 }
 
+// Previous balance of an address; used to restore the wallet.
+type etherJournalEntry struct {
+	address common.Address
+	balance big.Int
+}
+
 // Wallet that manages Ether.
 type EtherWallet struct {
 	balance map[common.Address]big.Int
+
+	// Balance changes since the last snapshot.
+	journal   []etherJournalEntry
+	journaled bool
 }
 
 // Create new Ether Wallet.
@@ -60,6 +70,13 @@ func (w *EtherWallet) Addresses() []common.Address {
 }
 
 func (w *EtherWallet) setBalance(address common.Address, value *big.Int) {
+	if w.journaled {
+		w.journal = append(w.journal, etherJournalEntry{address, w.balance[address]})
+	}
+	w.updateBalance(address, value)
+}
+
+func (w *EtherWallet) updateBalance(address common.Address, value *big.Int) {
 	if value.Sign() == 0 {
 		delete(w.balance, address)
 	} else {
@@ -67,6 +84,21 @@ func (w *EtherWallet) setBalance(address common.Address, value *big.Int) {
 	}
 }
 
+// Start recording the balance changes, so they can be undone by Restore.
+func (w *EtherWallet) Snapshot() {
+	w.journal = nil
+	w.journaled = true
+}
+
+// Undo the balance changes since the last snapshot.
+func (w *EtherWallet) Restore() {
+	for i := len(w.journal) - 1; i >= 0; i-- {
+		entry := w.journal[i]
+		w.updateBalance(entry.address, &entry.balance)
+	}
+	w.journal = nil
+}
+
 // Return the balance of the given address.
 func (w *EtherWallet) BalanceOf(address common.Address) *big.Int {
 	balance := w.balance[address]
@@ -128,8 +160,9 @@ func (w *EtherWallet) Withdraw(address common.Address, value *big.Int) ([]byte,
 	return EncodeEtherWithdraw(address, value), nil
 }
 
-// Handle a deposit from the Ether portal.
-func (w *EtherWallet) Deposit(payload []byte) (Deposit, []byte, error) {
+// Decode a deposit from the Ether portal without changing any wallet.
+// Return the deposit and the remaining payload.
+func DecodeEtherDeposit(payload []byte) (*EtherDeposit, []byte, error) {
 	if len(payload) < 20+32 {
 		return nil, nil, fmt.Errorf("invalid eth deposit size; got %v", len(payload))
 	}
@@ -140,13 +173,22 @@ func (w *EtherWallet) Deposit(payload []byte) (Deposit, []byte, error) {
 	value := new(big.Int).SetBytes(payload[:32])
 	payload = payload[32:]
 
-	newBalance := new(big.Int).Add(w.BalanceOf(sender), value)
+	return &EtherDeposit{sender, value}, payload, nil
+}
+
+// Handle a deposit from the Ether portal.
+func (w *EtherWallet) Deposit(payload []byte) (Deposit, []byte, error) {
+	deposit, payload, err := DecodeEtherDeposit(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newBalance := new(big.Int).Add(w.BalanceOf(deposit.Sender), deposit.Value)
 	if newBalance.Cmp(MaxUint256) > 0 {
 		// This should not be possible in real world, but we handle it anyway.
 		newBalance = MaxUint256
 	}
-	w.setBalance(sender, newBalance)
+	w.setBalance(deposit.Sender, newBalance)
 
-	deposit := &EtherDeposit{sender, value}
 	return deposit, payload, nil
 }
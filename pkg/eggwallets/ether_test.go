@@ -129,6 +129,61 @@ func TestBalanceOverflowEtherTransfer(t *testing.T) {
 	}
 }
 
+func TestEtherRestore(t *testing.T) {
+	src := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	dst := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet := NewEtherWallet()
+	wallet.setBalance(src, big.NewInt(50))
+	wallet.Snapshot()
+	err := wallet.Transfer(src, dst, big.NewInt(50))
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	_, err = wallet.Withdraw(dst, big.NewInt(20))
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	wallet.Restore()
+	srcBalance := wallet.BalanceOf(src)
+	if srcBalance.Cmp(big.NewInt(50)) != 0 {
+		t.Fatalf("expected 50 balance in src")
+	}
+	dstBalance := wallet.BalanceOf(dst)
+	if dstBalance.Sign() != 0 {
+		t.Fatalf("expected 0 balance in dst")
+	}
+	addresses := wallet.Addresses()
+	if len(addresses) != 1 {
+		t.Fatalf("expected 1 addresses; got %v", len(addresses))
+	}
+}
+
+func TestEtherRestoreAfterNewSnapshot(t *testing.T) {
+	src := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	dst := common.HexToAddress("0xfefefefefefefefefefefefefefefefefefefefe")
+	wallet := NewEtherWallet()
+	wallet.setBalance(src, big.NewInt(50))
+	wallet.Snapshot()
+	err := wallet.Transfer(src, dst, big.NewInt(10))
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	wallet.Snapshot()
+	err = wallet.Transfer(src, dst, big.NewInt(10))
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	wallet.Restore()
+	srcBalance := wallet.BalanceOf(src)
+	if srcBalance.Cmp(big.NewInt(40)) != 0 {
+		t.Fatalf("expected 40 balance in src")
+	}
+	dstBalance := wallet.BalanceOf(dst)
+	if dstBalance.Cmp(big.NewInt(10)) != 0 {
+		t.Fatalf("expected 10 balance in dst")
+	}
+}
+
 func TestEtherWithdrawEncode(t *testing.T) {
 	address := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
 	value := big.NewInt(100)
@@ -204,6 +259,26 @@ func TestValidEtherDeposit(t *testing.T) {
 	}
 }
 
+func TestDecodeEtherDeposit(t *testing.T) {
+	payload := common.Hex2Bytes("fafafafafafafafafafafafafafafafafafafafa0000000000000000000000000000000000000000000000000000000000000064deadbeef")
+	deposit, input, err := DecodeEtherDeposit(payload)
+	if err != nil {
+		t.Fatalf("expected nil err; got %v", err)
+	}
+	expectedAddress := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
+	if deposit.Sender != expectedAddress || deposit.Value.Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("wrong deposit: %v", deposit)
+	}
+	if common.Bytes2Hex(input) != "deadbeef" {
+		t.Fatal("wrong input")
+	}
+
+	_, _, err = DecodeEtherDeposit(payload[:51])
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func TestValidDepositWithEmptyInput(t *testing.T) {
 	wallet := NewEtherWallet()
 	payload := common.Hex2Bytes("fafafafafafafafafafafafafafafafafafafafa0000000000000000000000000000000000000000000000000000000000000064")
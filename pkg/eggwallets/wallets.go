@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"slices"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -50,3 +51,21 @@ func sortAddresses(addresses []common.Address) {
 		return 0
 	})
 }
+
+// Sort a slice of big ints.
+func sortBigInts(values []*big.Int) {
+	slices.SortFunc(values, func(a *big.Int, b *big.Int) int {
+		return a.Cmp(b)
+	})
+}
+
+// Decode the abi.encode(baseLayerData, execLayerData) sent by the NFT portals.
+func decodeLayerData(payload []byte) ([]byte, []byte, error) {
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	args := abi.Arguments{{Type: bytesType}, {Type: bytesType}}
+	values, err := args.UnpackValues(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode layer data: %v", err)
+	}
+	return values[0].([]byte), values[1].([]byte), nil
+}